@@ -1,5 +1,7 @@
 package axiom
 
+import "time"
+
 type AssertType string
 
 const (
@@ -12,6 +14,22 @@ const (
 
 	AssertNil    AssertType = "nil"
 	AssertNotNil AssertType = "not-nil"
+
+	AssertContains      AssertType = "contains"
+	AssertNotContains   AssertType = "not-contains"
+	AssertLen           AssertType = "len"
+	AssertGreater       AssertType = "greater"
+	AssertLess          AssertType = "less"
+	AssertInDelta       AssertType = "in-delta"
+	AssertRegexp        AssertType = "regexp"
+	AssertElementsMatch AssertType = "elements-match"
+	AssertJSONEqual     AssertType = "json-equal"
+
+	AssertErrorIs AssertType = "error-is"
+	AssertErrorAs AssertType = "error-as"
+
+	AssertEventually AssertType = "eventually"
+	AssertPanics     AssertType = "panics"
 )
 
 func (t AssertType) String() string {
@@ -27,6 +45,20 @@ type Assert struct {
 	Actual   any
 
 	Error error
+
+	// Delta is the allowed difference for AssertInDelta.
+	Delta float64
+
+	// WaitFor and Tick bound the polling of Condition for AssertEventually.
+	WaitFor   time.Duration
+	Tick      time.Duration
+	Condition func() bool
+
+	// Action is the function expected to panic for AssertPanics.
+	Action func()
+
+	// Result is filled by cfg.Assert once the assertion has been evaluated.
+	Result *AssertResult
 }
 
 type AssertOption func(*Assert)
@@ -60,6 +92,34 @@ func WithAssertError(err error) AssertOption {
 	return func(a *Assert) { a.Error = err }
 }
 
+func WithAssertDelta(delta float64) AssertOption {
+	return func(a *Assert) { a.Delta = delta }
+}
+
+func WithAssertCondition(condition func() bool, waitFor, tick time.Duration) AssertOption {
+	return func(a *Assert) {
+		a.Condition = condition
+		a.WaitFor = waitFor
+		a.Tick = tick
+	}
+}
+
+func WithAssertAction(action func()) AssertOption {
+	return func(a *Assert) { a.Action = action }
+}
+
+func WithAssertResult(result AssertResult) AssertOption {
+	return func(a *Assert) { a.Result = &result }
+}
+
+func (a Assert) Passed() bool {
+	return a.Result != nil && a.Result.Passed
+}
+
+func (a Assert) Failed() bool {
+	return a.Result != nil && !a.Result.Passed
+}
+
 func NewEqualAssert(expected, actual any, msg string) Assert {
 	return NewAssert(
 		WithAssertType(AssertEqual),
@@ -118,3 +178,125 @@ func NewNotNilAssert(actual any, msg string) Assert {
 		WithAssertMessage(msg),
 	)
 }
+
+func NewContainsAssert(container, element any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertContains),
+		WithAssertExpected(element),
+		WithAssertActual(container),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewNotContainsAssert(container, element any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertNotContains),
+		WithAssertExpected(element),
+		WithAssertActual(container),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewLenAssert(actual any, length int, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertLen),
+		WithAssertExpected(length),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+// NewGreaterAssert asserts that actual is greater than threshold.
+func NewGreaterAssert(actual, threshold any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertGreater),
+		WithAssertExpected(threshold),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+// NewLessAssert asserts that actual is less than threshold.
+func NewLessAssert(actual, threshold any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertLess),
+		WithAssertExpected(threshold),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewInDeltaAssert(expected, actual any, delta float64, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertInDelta),
+		WithAssertExpected(expected),
+		WithAssertActual(actual),
+		WithAssertDelta(delta),
+		WithAssertMessage(msg),
+	)
+}
+
+// NewRegexpAssert asserts that actual matches pattern, given either as a
+// string or as a *regexp.Regexp.
+func NewRegexpAssert(pattern, actual any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertRegexp),
+		WithAssertExpected(pattern),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewElementsMatchAssert(expected, actual any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertElementsMatch),
+		WithAssertExpected(expected),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewJSONEqualAssert(expected, actual string, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertJSONEqual),
+		WithAssertExpected(expected),
+		WithAssertActual(actual),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewErrorIsAssert(err, target error, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertErrorIs),
+		WithAssertExpected(target),
+		WithAssertError(err),
+		WithAssertMessage(msg),
+	)
+}
+
+// NewErrorAsAssert asserts that err has an error in its chain assignable to
+// target, which must be a non-nil pointer as for errors.As.
+func NewErrorAsAssert(err error, target any, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertErrorAs),
+		WithAssertExpected(target),
+		WithAssertError(err),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewEventuallyAssert(condition func() bool, waitFor, tick time.Duration, msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertEventually),
+		WithAssertCondition(condition, waitFor, tick),
+		WithAssertMessage(msg),
+	)
+}
+
+func NewPanicsAssert(action func(), msg string) Assert {
+	return NewAssert(
+		WithAssertType(AssertPanics),
+		WithAssertAction(action),
+		WithAssertMessage(msg),
+	)
+}
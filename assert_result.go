@@ -0,0 +1,529 @@
+package axiom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const corePackagePrefix = "github.com/Nikita-Filonov/axiom."
+
+const (
+	AssertAttrFailure = "failure"
+	AssertAttrDiff    = "diff"
+	AssertAttrSource  = "source"
+)
+
+type AssertResult struct {
+	Passed  bool   `json:"passed"`
+	Failure string `json:"failure,omitempty"`
+	Diff    string `json:"diff,omitempty"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+}
+
+// AssertEvaluator decides whether an assertion holds. Backends such as
+// testassert replace the default evaluator to reuse their own failure
+// messages; the result is attached to the Assert before sinks see it.
+type AssertEvaluator func(a Assert) AssertResult
+
+func (r AssertResult) Source() string {
+	if r.File == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", r.File, r.Line)
+}
+
+func assertPassed() AssertResult { return AssertResult{Passed: true} }
+
+func assertFailed(format string, args ...any) AssertResult {
+	return AssertResult{Failure: fmt.Sprintf(format, args...)}
+}
+
+// EvaluateAssert is the built-in evaluator. It has no dependency on an
+// assertion library, so results are available even without testassert.
+func EvaluateAssert(a Assert) AssertResult {
+	result := evaluateAssert(a)
+	if !result.Passed {
+		result.Diff = AssertDiff(a)
+	}
+
+	return result
+}
+
+func evaluateAssert(a Assert) AssertResult {
+	switch a.Type {
+	case AssertEqual:
+		if objectsAreEqual(a.Expected, a.Actual) {
+			return assertPassed()
+		}
+		return assertFailed("not equal:\nexpected: %#v\nactual  : %#v", a.Expected, a.Actual)
+
+	case AssertTrue, AssertFalse:
+		actual, ok := a.Actual.(bool)
+		if !ok {
+			return assertFailed("expected a bool, got %T", a.Actual)
+		}
+		if actual == (a.Type == AssertTrue) {
+			return assertPassed()
+		}
+		return assertFailed("should be %t", a.Type == AssertTrue)
+
+	case AssertError:
+		if a.Error != nil {
+			return assertPassed()
+		}
+		return assertFailed("an error is expected but got nil")
+
+	case AssertNoError:
+		if a.Error == nil {
+			return assertPassed()
+		}
+		return assertFailed("received unexpected error: %v", a.Error)
+
+	case AssertNil:
+		if isNil(a.Actual) {
+			return assertPassed()
+		}
+		return assertFailed("expected nil, got %#v", a.Actual)
+
+	case AssertNotNil:
+		if !isNil(a.Actual) {
+			return assertPassed()
+		}
+		return assertFailed("expected value not to be nil")
+
+	case AssertContains, AssertNotContains:
+		found, ok := containsElement(a.Actual, a.Expected)
+		if !ok {
+			return assertFailed("cannot look for an element in %T", a.Actual)
+		}
+		if found == (a.Type == AssertContains) {
+			return assertPassed()
+		}
+		if found {
+			return assertFailed("%#v should not contain %#v", a.Actual, a.Expected)
+		}
+		return assertFailed("%#v does not contain %#v", a.Actual, a.Expected)
+
+	case AssertLen:
+		length, ok := objectLen(a.Actual)
+		if !ok {
+			return assertFailed("%#v could not be applied builtin len()", a.Actual)
+		}
+		if expected, _ := a.Expected.(int); length == expected {
+			return assertPassed()
+		}
+		return assertFailed("%#v should have %v item(s), but has %d", a.Actual, a.Expected, length)
+
+	case AssertGreater, AssertLess:
+		order, ok := compareOrdered(a.Actual, a.Expected)
+		if !ok {
+			return assertFailed("cannot compare %T and %T", a.Actual, a.Expected)
+		}
+		if a.Type == AssertGreater && order > 0 || a.Type == AssertLess && order < 0 {
+			return assertPassed()
+		}
+		return assertFailed("%v is not %s than %v", a.Actual, a.Type, a.Expected)
+
+	case AssertInDelta:
+		expected, okExpected := toFloat(a.Expected)
+		actual, okActual := toFloat(a.Actual)
+		if !okExpected || !okActual {
+			return assertFailed("parameters must be numerical, got %T and %T", a.Expected, a.Actual)
+		}
+		if math.IsNaN(expected) || math.IsNaN(actual) {
+			return assertFailed("numbers must not be NaN")
+		}
+		if diff := math.Abs(expected - actual); diff <= a.Delta {
+			return assertPassed()
+		}
+		return assertFailed("max difference between %v and %v allowed is %v, but difference was %v",
+			a.Expected, a.Actual, a.Delta, math.Abs(expected-actual))
+
+	case AssertRegexp:
+		re, err := toRegexp(a.Expected)
+		if err != nil {
+			return assertFailed("invalid pattern: %v", err)
+		}
+		if re.MatchString(fmt.Sprint(a.Actual)) {
+			return assertPassed()
+		}
+		return assertFailed("expect %q to match %q", fmt.Sprint(a.Actual), re.String())
+
+	case AssertElementsMatch:
+		extraExpected, extraActual, ok := diffLists(a.Expected, a.Actual)
+		if !ok {
+			return assertFailed("expected lists, got %T and %T", a.Expected, a.Actual)
+		}
+		if len(extraExpected) == 0 && len(extraActual) == 0 {
+			return assertPassed()
+		}
+		return assertFailed("elements differ:\nextra elements in expected: %#v\nextra elements in actual  : %#v",
+			extraExpected, extraActual)
+
+	case AssertJSONEqual:
+		var expected, actual any
+		if err := json.Unmarshal([]byte(fmt.Sprint(a.Expected)), &expected); err != nil {
+			return assertFailed("expected value is not valid JSON: %v", err)
+		}
+		if err := json.Unmarshal([]byte(fmt.Sprint(a.Actual)), &actual); err != nil {
+			return assertFailed("actual value is not valid JSON: %v", err)
+		}
+		if reflect.DeepEqual(expected, actual) {
+			return assertPassed()
+		}
+		return assertFailed("JSON documents are not equal")
+
+	case AssertErrorIs:
+		target, _ := a.Expected.(error)
+		if errors.Is(a.Error, target) {
+			return assertPassed()
+		}
+		return assertFailed("target error should be in err chain:\nexpected: %v\nin chain: %v", target, a.Error)
+
+	case AssertErrorAs:
+		return evaluateErrorAs(a.Error, a.Expected)
+
+	case AssertEventually:
+		return evaluateEventually(a.Condition, a.WaitFor, a.Tick)
+
+	case AssertPanics:
+		if a.Action == nil {
+			return assertFailed("no function to call")
+		}
+		if didPanic(a.Action) {
+			return assertPassed()
+		}
+		return assertFailed("func should panic")
+	}
+
+	return assertFailed("unsupported assert type %q", a.Type)
+}
+
+func objectsAreEqual(expected, actual any) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+
+	exp, okExpected := expected.([]byte)
+	act, okActual := actual.([]byte)
+	if okExpected && okActual {
+		return bytes.Equal(exp, act)
+	}
+
+	return reflect.DeepEqual(expected, actual)
+}
+
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer,
+		reflect.Slice, reflect.UnsafePointer:
+		return value.IsNil()
+	}
+
+	return false
+}
+
+func objectLen(v any) (int, bool) {
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return value.Len(), true
+	}
+
+	return 0, false
+}
+
+func containsElement(container, element any) (found, ok bool) {
+	value := reflect.ValueOf(container)
+
+	switch value.Kind() {
+	case reflect.String:
+		return strings.Contains(value.String(), fmt.Sprint(element)), true
+
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if objectsAreEqual(key.Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if objectsAreEqual(value.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return false, true
+	}
+
+	return false, false
+}
+
+func toFloat(v any) (float64, bool) {
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+
+	if d, ok := v.(time.Duration); ok {
+		return float64(d), true
+	}
+
+	return 0, false
+}
+
+func compareOrdered(a, b any) (int, bool) {
+	if ta, ok := a.(time.Time); ok {
+		tb, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return ta.Compare(tb), true
+	}
+
+	if sa, ok := a.(string); ok {
+		sb, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(sa, sb), true
+	}
+
+	fa, okA := toFloat(a)
+	fb, okB := toFloat(b)
+	if !okA || !okB || reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return 0, false
+	}
+
+	switch {
+	case fa > fb:
+		return 1, true
+	case fa < fb:
+		return -1, true
+	}
+
+	return 0, true
+}
+
+func toRegexp(pattern any) (*regexp.Regexp, error) {
+	if re, ok := pattern.(*regexp.Regexp); ok && re != nil {
+		return re, nil
+	}
+
+	return regexp.Compile(fmt.Sprint(pattern))
+}
+
+func diffLists(expected, actual any) (extraExpected, extraActual []any, ok bool) {
+	exp, actualValue := reflect.ValueOf(expected), reflect.ValueOf(actual)
+	if !isList(exp) || !isList(actualValue) {
+		return nil, nil, false
+	}
+
+	visited := make([]bool, actualValue.Len())
+	for i := 0; i < exp.Len(); i++ {
+		element := exp.Index(i).Interface()
+		found := false
+		for j := 0; j < actualValue.Len(); j++ {
+			if !visited[j] && objectsAreEqual(actualValue.Index(j).Interface(), element) {
+				visited[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			extraExpected = append(extraExpected, element)
+		}
+	}
+	for j := 0; j < actualValue.Len(); j++ {
+		if !visited[j] {
+			extraActual = append(extraActual, actualValue.Index(j).Interface())
+		}
+	}
+
+	return extraExpected, extraActual, true
+}
+
+func isList(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+func evaluateErrorAs(err error, target any) (result AssertResult) {
+	value := reflect.ValueOf(target)
+	if target == nil || value.Kind() != reflect.Pointer || value.IsNil() {
+		return assertFailed("target must be a non-nil pointer, got %T", target)
+	}
+
+	// errors.As panics on targets it cannot assign to.
+	defer func() {
+		if r := recover(); r != nil {
+			result = assertFailed("invalid target %T: %v", target, r)
+		}
+	}()
+
+	if errors.As(err, target) {
+		return assertPassed()
+	}
+
+	return assertFailed("should be in error chain:\nexpected: %s\nin chain: %v", value.Elem().Type(), err)
+}
+
+func evaluateEventually(condition func() bool, waitFor, tick time.Duration) AssertResult {
+	if condition == nil {
+		return assertFailed("no condition to poll")
+	}
+	if tick <= 0 {
+		tick = waitFor
+	}
+
+	deadline := time.Now().Add(waitFor)
+	for {
+		if condition() {
+			return assertPassed()
+		}
+		if !time.Now().Add(tick).Before(deadline) {
+			return assertFailed("condition never satisfied within %s", waitFor)
+		}
+		time.Sleep(tick)
+	}
+}
+
+func didPanic(fn func()) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+		}
+	}()
+
+	fn()
+	return false
+}
+
+// AssertDiff renders a line diff between the expected and actual values of
+// comparison assertions, or an empty string when the type has none.
+func AssertDiff(a Assert) string {
+	var expected, actual string
+
+	switch a.Type {
+	case AssertEqual, AssertElementsMatch, AssertInDelta:
+		expected, actual = renderValue(a.Expected), renderValue(a.Actual)
+	case AssertJSONEqual:
+		expected, actual = renderJSON(fmt.Sprint(a.Expected)), renderJSON(fmt.Sprint(a.Actual))
+	default:
+		return ""
+	}
+
+	if expected == actual {
+		return ""
+	}
+
+	return lineDiff(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+}
+
+func renderValue(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case []byte:
+		return string(value)
+	}
+
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Pointer:
+		if data, err := json.MarshalIndent(v, "", "  "); err == nil {
+			return string(data)
+		}
+	}
+
+	return fmt.Sprintf("%#v", v)
+}
+
+func renderJSON(s string) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, []byte(s), "", "  "); err != nil {
+		return s
+	}
+
+	return out.String()
+}
+
+// lineDiff produces a minimal unified-style diff using the longest common
+// subsequence of lines; removed lines come from expected.
+func lineDiff(expected, actual []string) string {
+	lcs := make([][]int, len(expected)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(actual)+1)
+	}
+	for i := len(expected) - 1; i >= 0; i-- {
+		for j := len(actual) - 1; j >= 0; j-- {
+			if expected[i] == actual[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(expected) && j < len(actual) {
+		switch {
+		case expected[i] == actual[j]:
+			lines = append(lines, "  "+expected[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, "- "+expected[i])
+			i++
+		default:
+			lines = append(lines, "+ "+actual[j])
+			j++
+		}
+	}
+	for ; i < len(expected); i++ {
+		lines = append(lines, "- "+expected[i])
+	}
+	for ; j < len(actual); j++ {
+		lines = append(lines, "+ "+actual[j])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// assertCaller returns the first frame outside of the core package, which is
+// where the test code emitted the assertion.
+func assertCaller() (string, int) {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, corePackagePrefix) {
+			return frame.File, frame.Line
+		}
+		if !more {
+			return "", 0
+		}
+	}
+}
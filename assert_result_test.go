@@ -0,0 +1,88 @@
+package axiom_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+)
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "not found" }
+
+func TestEvaluateAssert(t *testing.T) {
+	var nilMap map[string]int
+
+	tests := []struct {
+		name   string
+		pass   axiom.Assert
+		failed axiom.Assert
+	}{
+		{"equal", axiom.NewEqualAssert([]byte("a"), []byte("a"), ""), axiom.NewEqualAssert(1, int64(1), "")},
+		{"true", axiom.NewTrueAssert(true, ""), axiom.NewTrueAssert(false, "")},
+		{"false", axiom.NewFalseAssert(false, ""), axiom.NewFalseAssert(true, "")},
+		{"error", axiom.NewErrorAssert(io.EOF, ""), axiom.NewErrorAssert(nil, "")},
+		{"no error", axiom.NewNoErrorAssert(nil, ""), axiom.NewNoErrorAssert(io.EOF, "")},
+		{"nil", axiom.NewNilAssert(nilMap, ""), axiom.NewNilAssert(0, "")},
+		{"not nil", axiom.NewNotNilAssert(0, ""), axiom.NewNotNilAssert(nilMap, "")},
+		{"contains", axiom.NewContainsAssert(map[string]int{"a": 1}, "a", ""), axiom.NewContainsAssert(42, 4, "")},
+		{"not contains", axiom.NewNotContainsAssert([]int{1}, 2, ""), axiom.NewNotContainsAssert("abc", "b", "")},
+		{"len", axiom.NewLenAssert("abc", 3, ""), axiom.NewLenAssert([]int{1}, 2, "")},
+		{"greater", axiom.NewGreaterAssert(time.Second, time.Millisecond, ""), axiom.NewGreaterAssert(1, 1.0, "")},
+		{"less", axiom.NewLessAssert("a", "b", ""), axiom.NewLessAssert(2, 1, "")},
+		{"in delta", axiom.NewInDeltaAssert(1, 1.05, 0.1, ""), axiom.NewInDeltaAssert(1.0, "1", 0.1, "")},
+		{"regexp", axiom.NewRegexpAssert(regexp.MustCompile(`^\d+$`), 42, ""), axiom.NewRegexpAssert(`(`, "x", "")},
+		{"elements match", axiom.NewElementsMatchAssert([]int{1, 1, 2}, []int{1, 2, 1}, ""), axiom.NewElementsMatchAssert([]int{1, 1}, []int{1, 2}, "")},
+		{"json equal", axiom.NewJSONEqualAssert(`{"a":[1,2]}`, ` {"a": [1, 2]} `, ""), axiom.NewJSONEqualAssert(`{}`, `nope`, "")},
+		{"error is", axiom.NewErrorIsAssert(fmt.Errorf("x: %w", io.EOF), io.EOF, ""), axiom.NewErrorIsAssert(errors.New("x"), io.EOF, "")},
+		{"error as", axiom.NewErrorAsAssert(fmt.Errorf("x: %w", notFoundError{}), new(notFoundError), ""), axiom.NewErrorAsAssert(io.EOF, notFoundError{}, "")},
+		{"eventually", axiom.NewEventuallyAssert(func() bool { return true }, time.Second, time.Millisecond, ""), axiom.NewEventuallyAssert(func() bool { return false }, 10*time.Millisecond, time.Millisecond, "")},
+		{"panics", axiom.NewPanicsAssert(func() { panic("boom") }, ""), axiom.NewPanicsAssert(nil, "")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pass := axiom.EvaluateAssert(tt.pass)
+			assert.True(t, pass.Passed, pass.Failure)
+			assert.Empty(t, pass.Failure)
+
+			failed := axiom.EvaluateAssert(tt.failed)
+			assert.False(t, failed.Passed)
+			assert.NotEmpty(t, failed.Failure)
+		})
+	}
+}
+
+func TestEvaluateAssert_UnsupportedType(t *testing.T) {
+	result := axiom.EvaluateAssert(axiom.NewAssert(axiom.WithAssertType("custom")))
+
+	assert.False(t, result.Passed)
+	assert.Equal(t, `unsupported assert type "custom"`, result.Failure)
+}
+
+func TestAssertDiff_Structs(t *testing.T) {
+	type user struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	diff := axiom.AssertDiff(axiom.NewEqualAssert(user{"alice", 30}, user{"alice", 31}, ""))
+
+	assert.Equal(t, "  {\n    \"name\": \"alice\",\n-   \"age\": 30\n+   \"age\": 31\n  }", diff)
+}
+
+func TestAssertDiff_JSON(t *testing.T) {
+	diff := axiom.AssertDiff(axiom.NewJSONEqualAssert(`{"a":1}`, `{"a":2}`, ""))
+
+	assert.Equal(t, "  {\n-   \"a\": 1\n+   \"a\": 2\n  }", diff)
+}
+
+func TestAssertDiff_NoComparison(t *testing.T) {
+	assert.Empty(t, axiom.AssertDiff(axiom.NewTrueAssert(false, "")))
+}
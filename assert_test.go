@@ -2,7 +2,9 @@ package axiom_test
 
 import (
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/stretchr/testify/assert"
@@ -117,3 +119,65 @@ func TestNewNotNilAssert(t *testing.T) {
 	assert.Nil(t, a.Expected)
 	assert.Nil(t, a.Error)
 }
+
+func TestNewContainsAssert(t *testing.T) {
+	a := axiom.NewContainsAssert([]string{"a", "b"}, "b", "must contain b")
+
+	assert.Equal(t, axiom.AssertContains, a.Type)
+	assert.Equal(t, "b", a.Expected)
+	assert.Equal(t, []string{"a", "b"}, a.Actual)
+}
+
+func TestNewLenAssert(t *testing.T) {
+	a := axiom.NewLenAssert([]int{1, 2}, 2, "two items")
+
+	assert.Equal(t, axiom.AssertLen, a.Type)
+	assert.Equal(t, 2, a.Expected)
+	assert.Equal(t, []int{1, 2}, a.Actual)
+}
+
+func TestNewGreaterAssert(t *testing.T) {
+	a := axiom.NewGreaterAssert(5, 3, "must be greater")
+
+	assert.Equal(t, axiom.AssertGreater, a.Type)
+	assert.Equal(t, 3, a.Expected)
+	assert.Equal(t, 5, a.Actual)
+}
+
+func TestNewInDeltaAssert(t *testing.T) {
+	a := axiom.NewInDeltaAssert(1.0, 1.05, 0.1, "close enough")
+
+	assert.Equal(t, axiom.AssertInDelta, a.Type)
+	assert.Equal(t, 1.0, a.Expected)
+	assert.Equal(t, 1.05, a.Actual)
+	assert.Equal(t, 0.1, a.Delta)
+}
+
+func TestNewErrorIsAssert(t *testing.T) {
+	target := errors.New("not found")
+	err := fmt.Errorf("load: %w", target)
+
+	a := axiom.NewErrorIsAssert(err, target, "must wrap not found")
+
+	assert.Equal(t, axiom.AssertErrorIs, a.Type)
+	assert.Equal(t, target, a.Expected)
+	assert.Equal(t, err, a.Error)
+}
+
+func TestNewEventuallyAssert(t *testing.T) {
+	a := axiom.NewEventuallyAssert(func() bool { return true }, time.Second, 10*time.Millisecond, "ready")
+
+	assert.Equal(t, axiom.AssertEventually, a.Type)
+	assert.Equal(t, time.Second, a.WaitFor)
+	assert.Equal(t, 10*time.Millisecond, a.Tick)
+	assert.True(t, a.Condition())
+}
+
+func TestNewPanicsAssert(t *testing.T) {
+	called := false
+	a := axiom.NewPanicsAssert(func() { called = true }, "must panic")
+
+	assert.Equal(t, axiom.AssertPanics, a.Type)
+	a.Action()
+	assert.True(t, called)
+}
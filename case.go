@@ -11,8 +11,11 @@ type Case struct {
 	Context     Context
 	Runtime     Runtime
 	Plugins     []Plugin
+	Timeout     Timeout
 	Parallel    Parallel
 	Fixtures    Fixtures
+	Quarantine  Quarantine
+	Soft        Soft
 	Description string
 }
 
@@ -42,6 +45,20 @@ func WithCaseSkip(opts ...SkipOption) CaseOption {
 	}
 }
 
+func WithCaseQuarantine(opts ...QuarantineOption) CaseOption {
+	return func(c *Case) {
+		q := NewQuarantine(opts...)
+		c.Quarantine = c.Quarantine.Join(q)
+	}
+}
+
+func WithCaseSoft(opts ...SoftOption) CaseOption {
+	return func(c *Case) {
+		s := NewSoft(opts...)
+		c.Soft = c.Soft.Join(s)
+	}
+}
+
 func WithCaseMeta(opts ...MetaOption) CaseOption {
 	return func(c *Case) {
 		m := NewMeta(opts...)
@@ -78,6 +95,13 @@ func WithCasePlugins(plugins ...Plugin) CaseOption {
 	return func(c *Case) { c.Plugins = append(c.Plugins, plugins...) }
 }
 
+func WithCaseTimeout(opts ...TimeoutOption) CaseOption {
+	return func(c *Case) {
+		t := NewTimeout(opts...)
+		c.Timeout = c.Timeout.Join(t)
+	}
+}
+
 func WithCaseParallel(opts ...ParallelOption) CaseOption {
 	return func(c *Case) {
 		p := NewParallel(opts...)
@@ -85,6 +109,12 @@ func WithCaseParallel(opts ...ParallelOption) CaseOption {
 	}
 }
 
+// WithCaseExclusive makes the case run alone: it waits until the other cases of
+// the runner finished and holds every other case back until it is done.
+func WithCaseExclusive() CaseOption {
+	return WithCaseParallel(WithParallelExclusive())
+}
+
 func WithCaseFixture(name string, fx Fixture) CaseOption {
 	return func(c *Case) {
 		if c.Fixtures.Registry == nil {
@@ -94,6 +124,18 @@ func WithCaseFixture(name string, fx Fixture) CaseOption {
 	}
 }
 
+func WithCaseFixtureDeps(name string, deps ...string) CaseOption {
+	return func(c *Case) { WithFixtureDeps(name, deps...)(&c.Fixtures) }
+}
+
+func WithCaseFixtureScope(name string, scope FixtureScope) CaseOption {
+	return func(c *Case) { WithFixtureScope(name, scope)(&c.Fixtures) }
+}
+
+func WithCaseFixtureKey[T any](key FixtureKey[T], fx TypedFixture[T]) CaseOption {
+	return WithCaseFixture(key.Name(), key.Fixture(fx))
+}
+
 func WithCaseDescription(desc string) CaseOption {
 	return func(c *Case) { c.Description = desc }
 }
@@ -109,8 +151,11 @@ func (c Case) Copy() Case {
 		Params:      c.Params,
 		Context:     c.Context.Copy(),
 		Runtime:     c.Runtime.Copy(),
+		Timeout:     c.Timeout.Copy(),
 		Parallel:    c.Parallel.Copy(),
 		Fixtures:    c.Fixtures.Copy(),
+		Quarantine:  c.Quarantine.Copy(),
+		Soft:        c.Soft.Copy(),
 		Description: c.Description,
 	}
 
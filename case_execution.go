@@ -1,6 +1,9 @@
 package axiom
 
 import (
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -13,6 +16,7 @@ type caseExecution struct {
 	runner       *Runner
 	baseConfig   *Config
 	caseTemplate Case
+	caseFixtures *fixtureStore
 }
 
 func newCaseExecution(runner *Runner, rootT *testing.T, testCase Case, action TestAction) *caseExecution {
@@ -26,6 +30,7 @@ func newCaseExecution(runner *Runner, rootT *testing.T, testCase Case, action Te
 		action:       action,
 		baseConfig:   baseConfig,
 		caseTemplate: testCase,
+		caseFixtures: newFixtureStore(rootT),
 	}
 }
 
@@ -53,34 +58,147 @@ func (e *caseExecution) runParallelRetry() {
 }
 
 func (e *caseExecution) runAttempts(parentT *testing.T, policies ...executionPolicy) {
+	var delay time.Duration
 	for attempt := 1; attempt <= e.baseConfig.Retry.Times; attempt++ {
-		e.waitBeforeAttempt(attempt)
-
 		attemptConfig := e.newAttemptConfig()
+		attemptConfig.Attempt = attempt
+		recorder := newAttemptRecorder(attemptConfig)
+		final := attempt == e.baseConfig.Retry.Times
+
 		ok := parentT.Run(attemptConfig.Case.Name, func(attemptT *testing.T) {
 			attemptConfig.SubT = attemptT
+			if final {
+				// A parallel attempt finishes after Run returns.
+				attemptT.Cleanup(e.caseFixtures.teardown)
+			}
+			span := attemptConfig.pushFrame(frameAttempt, "")
+			started := false
+			defer func() { attemptConfig.finishAttempt(span, started) }()
+
 			for _, policy := range policies {
 				policy(attemptConfig)
 			}
+			defer attemptConfig.acquireConcurrency()()
+
+			// The attempt starts once it holds its slot, so waiting for the
+			// slot is not part of its duration.
+			span.start = time.Now()
+			attemptConfig.emitSpan(span, EventTypeAttemptStart)
+			started = true
+
 			attemptConfig.Test(e.action)
 		})
 
-		if ok {
+		if final {
+			return
+		}
+		if ok || recorder != nil && !e.baseConfig.Retry.ShouldRetry(recorder.snapshot()) {
+			e.caseFixtures.teardown()
 			return
 		}
+
+		// Jittered backoffs differ between calls, so the reported delay is the
+		// one slept.
+		delay = e.baseConfig.Retry.DelayFor(attempt+1, delay)
+		attemptConfig.emit(NewEvent(
+			EventTypeRetryScheduled,
+			WithEventMessage(fmt.Sprintf("attempt %d of %d", attempt+1, e.baseConfig.Retry.Times)),
+			WithEventDuration(delay),
+		))
+		time.Sleep(delay)
+	}
+}
+
+// finishAttempt reports the outcome of an attempt. It runs deferred inside the
+// attempt subtest, so a parallel attempt reports once it has actually finished.
+// An attempt with quarantined failures is reported as skipped with the
+// "quarantined" status, whether it was stopped by Fatalf or ran to the end.
+// An attempt skipped before it started reports its start here.
+func (c *Config) finishAttempt(span frame, started bool) {
+	if !started {
+		c.emitSpan(span, EventTypeAttemptStart)
+	}
+
+	outcome := EventTypeCasePassed
+	message := ""
+	quarantined := c.QuarantinedFailures()
+	switch {
+	case c.SubT.Skipped() || len(quarantined) > 0 && !c.SubT.Failed():
+		outcome = EventTypeCaseSkipped
+		message = c.Skip.Reason
+		if len(quarantined) > 0 {
+			message = quarantined[0]
+		}
+	case c.SubT.Failed():
+		outcome = EventTypeCaseFailed
+		message = strings.Join(c.Failures(), "\n")
+	}
+
+	status := outcomeStatus(outcome)
+	if outcome == EventTypeCaseSkipped && len(quarantined) > 0 {
+		status = attemptStatusQuarantined
 	}
+
+	c.emit(NewEvent(outcome, WithEventMessage(message)))
+	c.emitSpan(span, EventTypeAttemptFinish, WithEventMessage(status), WithEventDuration(span.elapsed()))
+	c.popFrame(span)
+}
+
+const attemptStatusQuarantined = "quarantined"
+
+func outcomeStatus(outcome EventType) string {
+	return strings.TrimPrefix(string(outcome), "case.")
 }
 
 func (e *caseExecution) newAttemptConfig() *Config {
 	attemptCase := e.caseTemplate.Copy()
 	attemptConfig := e.runner.BuildConfig(e.rootT, &attemptCase)
+	attemptConfig.caseFixtures = e.caseFixtures
 	attemptConfig.ApplyPlugins()
 
 	return attemptConfig
 }
 
-func (e *caseExecution) waitBeforeAttempt(attempt int) {
-	if attempt > 1 && e.baseConfig.Retry.Delay > 0 {
-		time.Sleep(e.baseConfig.Retry.Delay)
+type attemptRecorder struct {
+	mu      sync.Mutex
+	attempt RetryAttempt
+}
+
+// newAttemptRecorder collects what a RetryOn predicate inspects. Recording is
+// skipped entirely when no predicate is configured.
+func newAttemptRecorder(cfg *Config) *attemptRecorder {
+	if cfg.Retry.RetryOn == nil {
+		return nil
+	}
+
+	r := &attemptRecorder{attempt: RetryAttempt{Number: cfg.Attempt}}
+	cfg.Runtime.EmitLogSink(func(l Log) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.attempt.Logs = append(r.attempt.Logs, l)
+	})
+	cfg.Runtime.EmitEventSink(func(e Event) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.attempt.Events = append(r.attempt.Events, e)
+	})
+	cfg.Runtime.EmitAssertSink(func(a Assert) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.attempt.Asserts = append(r.attempt.Asserts, a)
+	})
+
+	return r
+}
+
+func (r *attemptRecorder) snapshot() RetryAttempt {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return RetryAttempt{
+		Number:  r.attempt.Number,
+		Logs:    append([]Log{}, r.attempt.Logs...),
+		Events:  append([]Event{}, r.attempt.Events...),
+		Asserts: append([]Assert{}, r.attempt.Asserts...),
 	}
 }
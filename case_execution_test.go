@@ -1,9 +1,11 @@
 package axiom
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -245,6 +247,97 @@ func TestCaseExecution_ParallelRetry_SkipHelperProcess(t *testing.T) {
 	})
 }
 
+func TestCaseExecution_RetryOn_RetriesOnlyMatchingFailures(t *testing.T) {
+	for failure, expected := range map[string]string{
+		"transient": "retry-on attempts=3 numbers=[1 2 3]",
+		"mismatch":  "retry-on attempts=1 numbers=[1]",
+	} {
+		t.Run(failure, func(t *testing.T) {
+			output, err := runCaseExecutionHelper(
+				t,
+				"TestCaseExecution_RetryOn_HelperProcess",
+				"AXIOM_RETRY_ON_FAILURE="+failure,
+			)
+
+			require.Error(t, err)
+			assert.Contains(t, output, expected)
+		})
+	}
+}
+
+func TestCaseExecution_RetryOn_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	failure := os.Getenv("AXIOM_RETRY_ON_FAILURE")
+	var numbers []int
+
+	runner := NewRunner(
+		WithRunnerRetry(
+			WithRetryTimes(3),
+			WithRetryDelay(time.Millisecond),
+			WithRetryBackoff(NewExponentialBackoff(5*time.Millisecond)),
+			WithRetryOn(RetryOnMessage("connection reset")),
+		),
+	)
+
+	t.Cleanup(func() {
+		t.Logf("retry-on attempts=%d numbers=%v", len(numbers), numbers)
+	})
+
+	runner.RunCase(t, NewCase(WithCaseName("retry on")), func(cfg *Config) {
+		numbers = append(numbers, cfg.Attempt)
+
+		if failure == "transient" {
+			panic("read tcp: connection reset by peer")
+		}
+
+		cfg.Assert(NewEqualAssert(200, 500, "status code"))
+		cfg.SubT.Errorf("status code mismatch")
+	})
+}
+
+func TestCaseExecution_CaseScopedFixtureOutlivesFirstAttempt(t *testing.T) {
+	output, err := runCaseExecutionHelper(t, "TestCaseExecution_CaseScopedFixtureRetry_HelperProcess")
+
+	require.Error(t, err, "the failed first attempt keeps the helper process failed")
+	assert.Contains(t, output, "case-scoped setups=1 attempts=[1 2] errs=[<nil> <nil>] owner-is-case-test=true")
+}
+
+func TestCaseExecution_CaseScopedFixtureRetry_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	setups := 0
+	var owner *testing.T
+	runner := NewRunner(
+		WithRunnerRetry(WithRetryTimes(2)),
+		WithRunnerTimeout(WithTimeoutCase(time.Minute)),
+		WithRunnerFixture("db", func(cfg *Config) (any, func(), error) {
+			setups++
+			owner = cfg.T()
+			return cfg.Context.DB, nil, nil
+		}),
+		WithRunnerFixtureScope("db", FixtureScopeCase),
+	)
+
+	var attempts []int
+	var errs []error
+	t.Cleanup(func() {
+		t.Logf("case-scoped setups=%d attempts=%v errs=%v owner-is-case-test=%t", setups, attempts, errs, owner == t)
+	})
+
+	runner.RunCase(t, NewCase(WithCaseName("seeded")), func(cfg *Config) {
+		attempts = append(attempts, cfg.Attempt)
+		errs = append(errs, GetFixture[context.Context](cfg, "db").Err())
+		if cfg.Attempt == 1 {
+			cfg.Errorf("flaky")
+		}
+	})
+}
+
 func TestCaseExecution_SkipIsScopedToSelectedCase(t *testing.T) {
 	runner := NewRunner()
 	skippedActionRan := false
@@ -640,3 +733,385 @@ func TestCaseExecution_CreatesFreshAttemptConfigs(t *testing.T) {
 		t.Fatalf("attempt local value leaked: %q", value)
 	}
 }
+
+func TestCaseExecution_OutcomeEvents_FlakyCase(t *testing.T) {
+	output, err := runCaseExecutionHelper(t, "TestCaseExecution_OutcomeEvents_HelperProcess")
+
+	require.Error(t, err, "the failed first attempt keeps the helper process failed")
+	assert.Contains(t, output, "outcome events="+strings.Join([]string{
+		"attempt.start#1",
+		"case.failed#1:status 500",
+		"attempt.finish#1:failed",
+		"retry.scheduled#1:attempt 2 of 3@1ms",
+		"attempt.start#2",
+		"case.passed#2",
+		"attempt.finish#2:passed",
+	}, ","))
+	assert.Contains(t, output, "backoff calls=1", "the scheduled delay is the one slept")
+}
+
+func TestCaseExecution_OutcomeEvents_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	var events []string
+	calls := 0
+	runner := NewRunner(
+		WithRunnerRetry(
+			WithRetryTimes(3),
+			WithRetryDelay(time.Millisecond),
+			// Returns a different delay on every call.
+			WithRetryBackoff(func(_ int, delay, _ time.Duration) time.Duration {
+				calls++
+				return delay * time.Duration(calls)
+			}),
+		),
+		WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+			switch e.Type {
+			case EventTypeAttemptStart, EventTypeAttemptFinish, EventTypeRetryScheduled,
+				EventTypeCasePassed, EventTypeCaseFailed, EventTypeCaseSkipped:
+				entry := fmt.Sprintf("%s#%d", e.Type, e.Attempt)
+				if e.Message != "" {
+					entry += ":" + e.Message
+				}
+				if e.Type == EventTypeRetryScheduled {
+					entry += "@" + e.Duration.String()
+				}
+				events = append(events, entry)
+			}
+		})),
+	)
+
+	t.Cleanup(func() {
+		t.Logf("outcome events=%s", strings.Join(events, ","))
+		t.Logf("backoff calls=%d", calls)
+	})
+
+	runner.RunCase(t, NewCase(WithCaseName("flaky")), func(cfg *Config) {
+		if cfg.Attempt == 1 {
+			cfg.Errorf("status %d", 500)
+		}
+	})
+}
+
+func TestCaseExecution_OutcomeEvents_Skipped(t *testing.T) {
+	var events []Event
+	runner := NewRunner(
+		WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+			if strings.HasPrefix(string(e.Type), "attempt.") || strings.HasPrefix(string(e.Type), "case.") {
+				events = append(events, e)
+			}
+		})),
+	)
+
+	runner.RunCase(t, NewCase(
+		WithCaseName("skipped"),
+		WithCaseSkip(SkipBecause("not applicable")),
+	), func(cfg *Config) {})
+
+	var types []EventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []EventType{EventTypeAttemptStart, EventTypeCaseSkipped, EventTypeAttemptFinish}, types)
+	assert.Equal(t, "not applicable", events[1].Message)
+	assert.Equal(t, 1, events[1].Attempt)
+	assert.Equal(t, events[0].ID, events[1].ParentID)
+	assert.Equal(t, "skipped", events[2].Message)
+	assert.Equal(t, events[0].ID, events[2].ID)
+}
+
+func TestCaseExecution_OutcomeEvents_Quarantined(t *testing.T) {
+	tests := map[string]TestAction{
+		"errorf": func(cfg *Config) { cfg.Errorf("status %d", 500) },
+		"step panic": func(cfg *Config) {
+			cfg.Step("call", func() { panic("status 500") })
+		},
+		"fatalf": func(cfg *Config) { cfg.Fatalf("status %d", 500) },
+	}
+
+	for name, action := range tests {
+		t.Run(name, func(t *testing.T) {
+			var events []Event
+			runner := NewRunner(
+				WithRunnerQuarantine(QuarantineBecause("PAY-1")),
+				WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+					switch e.Type {
+					case EventTypeCasePassed, EventTypeCaseFailed, EventTypeCaseSkipped, EventTypeAttemptFinish:
+						events = append(events, e)
+					}
+				})),
+			)
+
+			runner.RunCase(t, NewCase(WithCaseName(name)), action)
+
+			require.Len(t, events, 2)
+			assert.Equal(t, EventTypeCaseSkipped, events[0].Type)
+			assert.Contains(t, events[0].Message, "status 500")
+			assert.Equal(t, EventTypeAttemptFinish, events[1].Type)
+			assert.Equal(t, "quarantined", events[1].Message)
+		})
+	}
+}
+
+func TestCaseExecution_OutcomeEvents_ParallelCaseReportsAfterFinishing(t *testing.T) {
+	var mu sync.Mutex
+	var outcome []EventType
+
+	t.Run("group", func(t *testing.T) {
+		runner := NewRunner(
+			WithRunnerParallel(),
+			WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+				mu.Lock()
+				defer mu.Unlock()
+				if e.Type == EventTypeCasePassed || e.Type == EventTypeCaseFinish {
+					outcome = append(outcome, e.Type)
+				}
+			})),
+		)
+
+		runner.RunCase(t, NewCase(WithCaseName("parallel")), func(cfg *Config) {
+			time.Sleep(5 * time.Millisecond)
+		})
+	})
+
+	assert.Equal(t, []EventType{EventTypeCaseFinish, EventTypeCasePassed}, outcome)
+}
+
+func TestCaseExecution_CaseScopedFixture_SharedAcrossAttempts(t *testing.T) {
+	for name, parallel := range map[string]string{"serial": "", "parallel": "1"} {
+		t.Run(name, func(t *testing.T) {
+			output, err := runCaseExecutionHelper(
+				t,
+				"TestCaseExecution_CaseScopedFixture_HelperProcess",
+				"AXIOM_FIXTURE_PARALLEL="+parallel,
+			)
+
+			require.Error(t, err, "the failed first attempt keeps the helper process failed")
+			assert.Contains(t, output, "case fixture order=setup,attempt 1:1,attempt 2:1,cleanup")
+		})
+	}
+}
+
+func TestCaseExecution_CaseScopedFixture_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	var mu sync.Mutex
+	var order []string
+	record := func(entry string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, entry)
+	}
+
+	setups := 0
+	options := []RunnerOption{
+		WithRunnerRetry(WithRetryTimes(3)),
+		WithRunnerFixture("db", func(cfg *Config) (any, func(), error) {
+			setups++
+			record("setup")
+			return setups, func() { record("cleanup") }, nil
+		}),
+		WithRunnerFixtureScope("db", FixtureScopeCase),
+	}
+	if os.Getenv("AXIOM_FIXTURE_PARALLEL") == "1" {
+		options = append(options, WithRunnerParallel(WithParallelEnabled()))
+	}
+
+	t.Cleanup(func() {
+		t.Logf("case fixture order=%s", strings.Join(order, ","))
+	})
+
+	NewRunner(options...).RunCase(t, NewCase(WithCaseName("flaky")), func(cfg *Config) {
+		record(fmt.Sprintf("attempt %d:%d", cfg.Attempt, GetFixture[int](cfg, "db")))
+		if cfg.Attempt == 1 {
+			cfg.Errorf("status %d", 500)
+		}
+	})
+}
+
+func TestCaseExecution_ParallelLimits_ThrottleRunningCases(t *testing.T) {
+	output, err := runCaseExecutionHelper(t, "TestCaseExecution_ParallelLimits_HelperProcess")
+
+	require.NoError(t, err, output)
+	assert.Contains(t, output, "parallel limits peak=2 payments-peak=1 acquired=8 released=8 waited=true")
+}
+
+func TestCaseExecution_ParallelLimits_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	var mu sync.Mutex
+	acquired, released, waited := 0, 0, false
+	runner := NewRunner(
+		WithRunnerParallel(
+			WithParallelEnabled(),
+			WithParallelMaxConcurrency(2),
+			WithParallelGroupLimit("payments-db", 1),
+		),
+		WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch e.Type {
+			case EventTypeParallelSlotAcquired:
+				acquired++
+				waited = waited || e.Duration > 0
+			case EventTypeParallelSlotReleased:
+				released++
+			}
+		})),
+	)
+
+	var running, peak, payments, paymentsPeak atomic.Int64
+
+	t.Run("cases", func(t *testing.T) {
+		for i := range 8 {
+			var options []CaseOption
+			options = append(options, WithCaseName(fmt.Sprintf("case %d", i)))
+			payment := i%2 == 0
+			if payment {
+				options = append(options, WithCaseParallel(WithParallelGroup("payments-db")))
+			}
+
+			runner.RunCase(t, NewCase(options...), func(cfg *Config) {
+				defer trackRunning(&running, &peak)()
+				if payment {
+					defer trackRunning(&payments, &paymentsPeak)()
+				}
+				time.Sleep(20 * time.Millisecond)
+			})
+		}
+	})
+
+	t.Logf(
+		"parallel limits peak=%d payments-peak=%d acquired=%d released=%d waited=%t",
+		peak.Load(), paymentsPeak.Load(), acquired, released, waited,
+	)
+}
+
+func TestCaseExecution_ExclusiveCase_RunsAlone(t *testing.T) {
+	output, err := runCaseExecutionHelper(t, "TestCaseExecution_ExclusiveCase_HelperProcess")
+
+	require.NoError(t, err, output)
+	assert.Contains(t, output, "exclusive overlap=false shared-peak=3 exclusive-acquired=1")
+}
+
+func TestCaseExecution_ExclusiveCase_HelperProcess(t *testing.T) {
+	if os.Getenv(caseExecutionHelperEnv) != "1" {
+		t.Skip("helper process")
+	}
+
+	var mu sync.Mutex
+	exclusiveAcquired := 0
+	runner := NewRunner(
+		WithRunnerParallel(WithParallelEnabled(), WithParallelMaxConcurrency(3)),
+		WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			if e.Type == EventTypeParallelSlotAcquired && e.Message == "exclusive" {
+				exclusiveAcquired++
+			}
+		})),
+	)
+
+	var running, peak atomic.Int64
+	var exclusive, overlap atomic.Bool
+
+	t.Run("cases", func(t *testing.T) {
+		for i := range 7 {
+			if i == 3 {
+				runner.RunCase(t, NewCase(WithCaseName("migrate schema"), WithCaseExclusive()), func(cfg *Config) {
+					exclusive.Store(true)
+					defer exclusive.Store(false)
+
+					if running.Load() != 0 {
+						overlap.Store(true)
+					}
+					time.Sleep(30 * time.Millisecond)
+					if running.Load() != 0 {
+						overlap.Store(true)
+					}
+				})
+				continue
+			}
+
+			runner.RunCase(t, NewCase(WithCaseName(fmt.Sprintf("case %d", i))), func(cfg *Config) {
+				defer trackRunning(&running, &peak)()
+				if exclusive.Load() {
+					overlap.Store(true)
+				}
+				time.Sleep(20 * time.Millisecond)
+			})
+		}
+	})
+
+	t.Logf("exclusive overlap=%t shared-peak=%d exclusive-acquired=%d", overlap.Load(), peak.Load(), exclusiveAcquired)
+}
+
+func TestCaseExecution_AttemptStartsAfterSlotIsAcquired(t *testing.T) {
+	var types []EventType
+	runner := NewRunner(
+		WithRunnerParallel(WithParallelMaxConcurrency(1)),
+		WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+			switch e.Type {
+			case EventTypeAttemptStart, EventTypeAttemptFinish, EventTypeParallelSlotAcquired, EventTypeParallelSlotReleased:
+				types = append(types, e.Type)
+			}
+		})),
+	)
+
+	runner.RunCase(t, NewCase(WithCaseName("limited")), func(cfg *Config) {})
+
+	assert.Equal(t, []EventType{
+		EventTypeParallelSlotAcquired,
+		EventTypeAttemptStart,
+		EventTypeParallelSlotReleased,
+		EventTypeAttemptFinish,
+	}, types)
+}
+
+func TestCaseExecution_NestedCaseRunsWithinSlotOfParent(t *testing.T) {
+	tests := map[string]CaseOption{
+		"max concurrency": WithCaseParallel(WithParallelMaxConcurrency(1)),
+		"exclusive":       WithCaseExclusive(),
+	}
+
+	for name, option := range tests {
+		t.Run(name, func(t *testing.T) {
+			var acquired int
+			runner := NewRunner(WithRunnerRuntime(WithRuntimeEventSink(func(e Event) {
+				if e.Type == EventTypeParallelSlotAcquired {
+					acquired++
+				}
+			})))
+
+			var ran []string
+			runner.RunCase(t, NewCase(WithCaseName("outer"), option), func(cfg *Config) {
+				runner.RunCase(cfg.SubT, NewCase(WithCaseName("inner"), option), func(cfg *Config) {
+					ran = append(ran, "inner")
+				})
+				ran = append(ran, "outer")
+			})
+
+			assert.Equal(t, []string{"inner", "outer"}, ran)
+			assert.Equal(t, 1, acquired, "the nested case takes no slot of its own")
+		})
+	}
+}
+
+// trackRunning counts a running case and records the highest count seen.
+func trackRunning(running, peak *atomic.Int64) func() {
+	current := running.Add(1)
+	for {
+		seen := peak.Load()
+		if current <= seen || peak.CompareAndSwap(seen, current) {
+			break
+		}
+	}
+
+	return func() { running.Add(-1) }
+}
@@ -107,6 +107,16 @@ func TestWithCaseParallel_Disabled(t *testing.T) {
 	assert.False(t, c.Parallel.Enabled)
 }
 
+func TestWithCaseExclusive(t *testing.T) {
+	c := axiom.NewCase(
+		axiom.WithCaseParallel(axiom.WithParallelEnabled()),
+		axiom.WithCaseExclusive(),
+	)
+
+	assert.True(t, c.Parallel.Enabled)
+	assert.True(t, c.Parallel.Exclusive)
+}
+
 func TestWithCaseDescription(t *testing.T) {
 	c := axiom.NewCase(axiom.WithCaseDescription("test-description"))
 
@@ -0,0 +1,138 @@
+package axiom
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// concurrencyLimiter counts the running attempts of a runner, in total and
+// per concurrency group, and lets exclusive attempts run alone.
+type concurrencyLimiter struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	running int
+	groups  map[string]int
+	holders map[string]int
+
+	exclusive        bool
+	exclusiveWaiting int
+}
+
+// acquire waits until p fits all of its limits and then takes a slot in the
+// runner and in every group of p at once, so no case holds one slot while
+// waiting for another. An exclusive attempt waits for running attempts to
+// drain; attempts arriving meanwhile wait behind it, so it is not starved.
+//
+// name is the name of the attempt subtest. An attempt nested in the subtest of
+// an attempt holding a slot, i.e. a case run from within a running case, would
+// wait for its parent forever; it takes no slot and acquire reports false.
+func (l *concurrencyLimiter) acquire(p Parallel, name string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.cond == nil {
+		l.cond = sync.NewCond(&l.mu)
+		l.groups = map[string]int{}
+		l.holders = map[string]int{}
+	}
+	for holder := range l.holders {
+		if strings.HasPrefix(name, holder+"/") {
+			return false
+		}
+	}
+
+	if p.Exclusive {
+		l.exclusiveWaiting++
+		for l.running > 0 {
+			l.cond.Wait()
+		}
+		l.exclusiveWaiting--
+		l.exclusive = true
+	} else {
+		for l.exclusive || l.exclusiveWaiting > 0 || !l.fitsLocked(p) {
+			l.cond.Wait()
+		}
+	}
+
+	l.running++
+	for _, group := range p.Groups {
+		l.groups[group]++
+	}
+	l.holders[name]++
+
+	return true
+}
+
+func (l *concurrencyLimiter) release(p Parallel, name string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.holders[name]--; l.holders[name] == 0 {
+		delete(l.holders, name)
+	}
+	l.running--
+	for _, group := range p.Groups {
+		l.groups[group]--
+	}
+	if p.Exclusive {
+		l.exclusive = false
+	}
+	l.cond.Broadcast()
+}
+
+func (l *concurrencyLimiter) fitsLocked(p Parallel) bool {
+	if p.MaxConcurrency > 0 && l.running >= p.MaxConcurrency {
+		return false
+	}
+	for _, group := range p.Groups {
+		if limit := p.GroupLimits[group]; limit > 0 && l.groups[group] >= limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// acquireConcurrency waits for a slot of the runner before the attempt runs
+// and returns the function that releases it. Every attempt takes a slot, so
+// limits also count cases that have none of their own. A case run from within
+// a running case of the same runner runs within the slot of that case.
+func (c *Config) acquireConcurrency() func() {
+	if c.Runner == nil {
+		return func() {}
+	}
+
+	name := c.SubT.Name()
+	start := time.Now()
+	if !c.Runner.concurrency.acquire(c.Parallel, name) {
+		return func() {}
+	}
+	if c.Parallel.Limited() {
+		c.emit(NewEvent(
+			EventTypeParallelSlotAcquired,
+			WithEventName(strings.Join(c.Parallel.Groups, ",")),
+			WithEventMessage(c.slotMode()),
+			WithEventDuration(time.Since(start)),
+		))
+	}
+
+	return func() {
+		c.Runner.concurrency.release(c.Parallel, name)
+		if c.Parallel.Limited() {
+			c.emit(NewEvent(
+				EventTypeParallelSlotReleased,
+				WithEventName(strings.Join(c.Parallel.Groups, ",")),
+				WithEventMessage(c.slotMode()),
+			))
+		}
+	}
+}
+
+func (c *Config) slotMode() string {
+	if c.Parallel.Exclusive {
+		return "exclusive"
+	}
+
+	return "shared"
+}
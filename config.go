@@ -1,25 +1,55 @@
 package axiom
 
 import (
+	"fmt"
+	"runtime"
+	"sync"
 	"testing"
 )
 
 type Config struct {
+	mu          sync.Mutex
+	frames      []frame
+	failures    []string
+	softScopes  []*softScope
+	quarantined []string
+	timeouts    []*timeoutScope
+
+	// parent is the Config this one was derived from. Derived configs share
+	// the state of their parent through root and spans; a branch of
+	// ParallelSteps keeps frames, soft scopes, timeouts and failures of its
+	// own.
+	parent    *Config
+	branch    bool
+	resolving []string
+
+	fixturesErr  error
+	fixtureMu    sync.Mutex
+	fixtureCalls map[string]*fixtureCall
+	caseFixtures *fixtureStore
+	leaseMu      sync.Mutex
+	leases       map[string]ResourceLease
+	leaseCalls   map[string]*leaseCall
+
 	RootT *testing.T
 	SubT  *testing.T
 
-	Runner *Runner
-	Case   *Case
+	Runner  *Runner
+	Case    *Case
+	Attempt int
 
-	Meta     Meta
-	Skip     Skip
-	Retry    Retry
-	Local    Local
-	Hooks    Hooks
-	Context  Context
-	Runtime  Runtime
-	Parallel Parallel
-	Fixtures Fixtures
+	Meta       Meta
+	Skip       Skip
+	Retry      Retry
+	Local      Local
+	Hooks      Hooks
+	Context    Context
+	Runtime    Runtime
+	Timeout    Timeout
+	Parallel   Parallel
+	Fixtures   Fixtures
+	Quarantine Quarantine
+	Soft       Soft
 }
 
 func (c *Config) T() *testing.T {
@@ -30,93 +60,248 @@ func (c *Config) T() *testing.T {
 	return c.RootT
 }
 
+// derive returns a Config for code that runs on behalf of c, such as the setup
+// of a fixture or a branch of ParallelSteps.
+func (c *Config) derive() *Config {
+	return &Config{
+		parent:    c,
+		resolving: c.resolving,
+
+		RootT:   c.RootT,
+		SubT:    c.SubT,
+		Runner:  c.Runner,
+		Case:    c.Case,
+		Attempt: c.Attempt,
+
+		Meta:    c.Meta,
+		Skip:    c.Skip,
+		Retry:   c.Retry,
+		Hooks:   c.Hooks,
+		Context: c.Context.derive(),
+		Runtime: c.Runtime,
+		Timeout: c.Timeout,
+		Fixtures: Fixtures{
+			Registry: c.Fixtures.Registry,
+			Deps:     c.Fixtures.Deps,
+			Scopes:   c.Fixtures.Scopes,
+		},
+		Parallel:   c.Parallel,
+		Quarantine: c.Quarantine,
+		Soft:       c.Soft,
+	}
+}
+
+// root returns the Config of the attempt, which holds fixtures, Local and
+// leases for every config derived from it.
+func (c *Config) root() *Config {
+	for c.parent != nil {
+		c = c.parent
+	}
+
+	return c
+}
+
+// spans returns the Config holding the frames, soft scopes, timeouts and
+// failures of c: the innermost branch of ParallelSteps, or the attempt.
+func (c *Config) spans() *Config {
+	for c.parent != nil && !c.branch {
+		c = c.parent
+	}
+
+	return c
+}
+
+// Errorf reports a failure of the current attempt. Failures of a quarantined
+// attempt are logged and emitted as events instead of failing the test.
+func (c *Config) Errorf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if c.quarantine(message) {
+		return
+	}
+	c.recordFailure(message)
+	if c.SubT != nil {
+		c.SubT.Helper()
+		c.SubT.Errorf("%s", message)
+	}
+}
+
+func (c *Config) Fatalf(format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if c.SubT == nil {
+		panic(message)
+	}
+
+	c.SubT.Helper()
+	quarantined := c.quarantine(message)
+	if !quarantined {
+		c.recordFailure(message)
+	}
+	if c.spans().branch {
+		// FailNow and SkipNow must run on the test goroutine, so a branch of
+		// ParallelSteps only ends itself and ParallelSteps stops the test.
+		if !quarantined {
+			c.SubT.Errorf("%s", message)
+		}
+		runtime.Goexit()
+	}
+	if quarantined {
+		c.SubT.SkipNow()
+	}
+	c.SubT.Fatalf("%s", message)
+}
+
+// Failures returns failures reported through Errorf and Fatalf. Failures
+// reported on the testing.T directly are not included.
+func (c *Config) Failures() []string {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	return append([]string{}, spans.failures...)
+}
+
+func (c *Config) QuarantinedFailures() []string {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	return append([]string{}, spans.quarantined...)
+}
+
+// recordFailure records message for c and every branch it runs in.
+func (c *Config) recordFailure(message string) {
+	for spans := c.spans(); ; spans = spans.parent.spans() {
+		spans.mu.Lock()
+		spans.failures = append(spans.failures, message)
+		spans.mu.Unlock()
+
+		if spans.parent == nil {
+			return
+		}
+	}
+}
+
 func (c *Config) Log(l Log) {
-	c.Event(NewLogEvent(l))
+	l = c.enrichLog(l)
+	c.emit(NewLogEvent(l))
 	c.Runtime.Log(l)
 }
 
 func (c *Config) Step(name string, fn func()) {
-	c.Event(NewEvent(EventTypeStepStart, WithEventName(name)))
+	span := c.pushFrame(frameStep, name)
+	c.emitSpan(span, EventTypeStepStart)
+	timeout := newTimeoutScope(c, "step", c.Timeout.Step)
+	soft := c.softScopeFor(SoftScopeStep, name)
 	defer func() {
 		if r := recover(); r != nil {
-			c.Event(NewEvent(EventTypeStepPanic, WithEventName(name), WithEventMessage(r)))
-			if c.SubT != nil {
-				c.SubT.Helper()
-				c.SubT.Errorf("panic in step %q: %v", name, r)
-			}
+			c.emitSpan(span, EventTypeStepPanic, WithEventMessage(r))
+			c.Errorf("panic in step %q: %v", name, r)
+		}
+		if expired, _ := timeout.expired(); expired {
+			c.emitSpan(span, EventTypeStepTimeout, WithEventMessage(timeout.cause))
+			c.Errorf("step %q timed out after %s", name, c.Timeout.Step)
+		}
+		if soft != nil {
+			c.flushSoftScope(soft)
 		}
 
+		timeout.restore(c)
+		c.popFrame(span)
 		c.Hooks.ApplyAfterStep(c, name)
-		c.Event(NewEvent(EventTypeStepFinish, WithEventName(name)))
+		c.emitSpan(span, EventTypeStepFinish, WithEventDuration(span.elapsed()))
 	}()
 
 	c.Hooks.ApplyBeforeStep(c, name)
-	c.Runtime.Step(name, fn)
+	c.Runtime.Span(c, name, func() { c.Runtime.Step(name, fn) })
 }
 
 func (c *Config) Test(action TestAction) {
-	c.Event(NewEvent(EventTypeCaseStart))
+	span := c.pushFrame(frameCase, "")
+	c.emitSpan(span, EventTypeCaseStart)
+	timeout := newTimeoutScope(c, "case", c.Timeout.Case)
+	soft := c.softScopeFor(SoftScopeCase, "")
 	defer func() {
 		if r := recover(); r != nil {
-			c.Event(NewEvent(EventTypeCasePanic, WithEventMessage(r)))
-			if c.SubT != nil {
-				c.SubT.Helper()
-				c.SubT.Errorf("panic in test %q: %v", c.Case.Name, r)
-			}
+			c.emitSpan(span, EventTypeCasePanic, WithEventMessage(r))
+			c.Errorf("panic in test %q: %v", c.Case.Name, r)
+		}
+		if expired, step := timeout.expired(); expired {
+			c.emitSpan(span, EventTypeCaseTimeout, WithEventName(step), WithEventMessage(timeout.cause))
+			c.Errorf("%s", timeoutMessage(c.Case.Name, c.Timeout.Case, step))
+		}
+		if soft != nil {
+			c.flushSoftScope(soft)
 		}
 
+		defer timeout.restore(c)
+		defer c.popFrame(span)
 		defer c.Fixtures.Teardown(c)
 		c.Hooks.ApplyAfterTest(c)
-		c.Event(NewEvent(EventTypeCaseFinish))
+		c.emitSpan(span, EventTypeCaseFinish, WithEventDuration(span.elapsed()))
 	}()
 
+	if c.fixturesErr != nil {
+		c.Fatalf("invalid fixtures: %v", c.fixturesErr)
+	}
+	c.setupDeclaredFixtures()
+
 	c.Hooks.ApplyBeforeTest(c)
 	c.Runtime.Test(c, action)
 }
 
+// Event passes e to the event sinks as is. Events emitted by Axiom itself are
+// additionally stamped with the case identity, attempt, step path and span.
 func (c *Config) Event(e Event) { c.Runtime.Event(e) }
 
 func (c *Config) Setup(name string, fn func()) {
-	c.Event(NewEvent(EventTypeSetupStart, WithEventName(name)))
+	span := c.pushFrame(frameSetup, name)
+	c.emitSpan(span, EventTypeSetupStart)
 	defer func() {
 		if r := recover(); r != nil {
-			c.Event(NewEvent(EventTypeSetupPanic, WithEventName(name), WithEventMessage(r)))
-			if c.SubT != nil {
-				c.SubT.Helper()
-				c.SubT.Errorf("panic in setup %q: %v", name, r)
-			}
+			c.emitSpan(span, EventTypeSetupPanic, WithEventMessage(r))
+			c.Errorf("panic in setup %q: %v", name, r)
 		}
 
-		c.Event(NewEvent(EventTypeSetupFinish, WithEventName(name)))
+		c.popFrame(span)
+		c.emitSpan(span, EventTypeSetupFinish, WithEventDuration(span.elapsed()))
 	}()
 
-	c.Runtime.Setup(name, fn)
+	c.Runtime.Span(c, name, func() { c.Runtime.Setup(name, fn) })
 }
 
 func (c *Config) Teardown(name string, fn func()) {
-	c.Event(NewEvent(EventTypeTeardownStart, WithEventName(name)))
+	span := c.pushFrame(frameTeardown, name)
+	c.emitSpan(span, EventTypeTeardownStart)
 	defer func() {
 		if r := recover(); r != nil {
-			c.Event(NewEvent(EventTypeTeardownPanic, WithEventName(name), WithEventMessage(r)))
-			if c.SubT != nil {
-				c.SubT.Helper()
-				c.SubT.Errorf("panic in teardown %q: %v", name, r)
-			}
+			c.emitSpan(span, EventTypeTeardownPanic, WithEventMessage(r))
+			c.Errorf("panic in teardown %q: %v", name, r)
 		}
 
-		c.Event(NewEvent(EventTypeTeardownFinish, WithEventName(name)))
+		c.popFrame(span)
+		c.emitSpan(span, EventTypeTeardownFinish, WithEventDuration(span.elapsed()))
 	}()
 
-	c.Runtime.Teardown(name, fn)
+	c.Runtime.Span(c, name, func() { c.Runtime.Teardown(name, fn) })
 }
 
+// Assert evaluates the assertion once, attaches the result and the source
+// location, and hands it to the event stream and the assert sinks.
 func (c *Config) Assert(a Assert) {
-	c.Event(NewAssertEvent(a))
+	if a.Result == nil {
+		result := c.Runtime.EvaluateAssert(a)
+		if result.File == "" {
+			result.File, result.Line = assertCaller()
+		}
+		a.Result = &result
+	}
+
+	c.emit(NewAssertEvent(a))
 	c.Runtime.Assert(a)
 }
 
 func (c *Config) Artefact(a Artefact) {
-	c.Event(NewArtefactEvent(a))
+	c.emit(NewArtefactEvent(a))
 	c.Runtime.Artefact(a)
 }
 
@@ -129,6 +314,29 @@ func (c *Config) ApplyPlugins() {
 	}
 }
 
+func (c *Config) quarantine(message string) bool {
+	if !c.Quarantine.Enabled {
+		return false
+	}
+
+	for spans := c.spans(); ; spans = spans.parent.spans() {
+		spans.mu.Lock()
+		spans.quarantined = append(spans.quarantined, message)
+		spans.mu.Unlock()
+
+		if spans.parent == nil {
+			break
+		}
+	}
+
+	c.emit(NewEvent(EventTypeCaseQuarantined, WithEventName(c.Quarantine.Reason), WithEventMessage(message)))
+	if c.SubT != nil {
+		c.SubT.Logf("quarantined failure: %s", message)
+	}
+
+	return true
+}
+
 func (c *Config) applySkipPolicy() {
 	if c.Skip.Enabled {
 		c.T().Skip(c.Skip.Reason)
@@ -140,3 +348,36 @@ func (c *Config) applyParallelPolicy() {
 		c.T().Parallel()
 	}
 }
+
+// enrichLog adds the case context to a log so that sinks can group output per
+// test. Attributes set explicitly on the log take precedence.
+func (c *Config) enrichLog(l Log) Log {
+	var attrs []Attr
+	add := func(key string, value any) {
+		if _, ok := l.Attr(key); !ok {
+			attrs = append(attrs, NewAttr(key, value))
+		}
+	}
+
+	if c.Case != nil {
+		if c.Case.ID != "" {
+			add(LogAttrCaseID, c.Case.ID)
+		}
+		if c.Case.Name != "" {
+			add(LogAttrCaseName, c.Case.Name)
+		}
+	}
+	if c.Attempt > 0 {
+		add(LogAttrAttempt, c.Attempt)
+	}
+	if step := c.currentStep(); step != "" {
+		add(LogAttrStep, step)
+	}
+
+	if len(attrs) == 0 {
+		return l
+	}
+
+	l.Attrs = append(attrs, l.Attrs...)
+	return l
+}
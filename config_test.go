@@ -216,6 +216,43 @@ func TestConfig_Log_DelegatesToRuntimeSink(t *testing.T) {
 	assert.Equal(t, "hello", events[0].Message)
 }
 
+func TestConfig_Log_EnrichesWithCaseContext(t *testing.T) {
+	var received axiom.Log
+	var events []axiom.Event
+
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{ID: "AX-1", Name: "create user"},
+		Attempt: 2,
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeLogSink(func(l axiom.Log) { received = l }),
+			axiom.WithRuntimeEventSink(func(e axiom.Event) { events = append(events, e) }),
+		),
+	}
+
+	cfg.Step("create", func() {
+		cfg.Log(axiom.NewLog(
+			axiom.WithLogText("created"),
+			axiom.WithLogAttr("user_id", 42),
+			axiom.WithLogAttr(axiom.LogAttrStep, "custom"),
+		))
+	})
+
+	expected := []axiom.Attr{
+		{Key: axiom.LogAttrCaseID, Value: "AX-1"},
+		{Key: axiom.LogAttrCaseName, Value: "create user"},
+		{Key: axiom.LogAttrAttempt, Value: 2},
+		{Key: "user_id", Value: 42},
+		{Key: axiom.LogAttrStep, Value: "custom"},
+	}
+	assert.Equal(t, expected, received.Attrs)
+
+	for _, e := range events {
+		if e.Type == axiom.EventTypeLog {
+			assert.Equal(t, expected, e.Attrs)
+		}
+	}
+}
+
 func TestConfig_Assert_DelegatesToRuntimeSink(t *testing.T) {
 	var received axiom.Assert
 	var events []axiom.Event
@@ -232,12 +269,55 @@ func TestConfig_Assert_DelegatesToRuntimeSink(t *testing.T) {
 
 	cfg.Assert(input)
 
-	assert.Equal(t, input, received)
+	assert.Equal(t, input.Type, received.Type)
+	assert.Equal(t, input.Message, received.Message)
+	require.NotNil(t, received.Result)
+	assert.True(t, received.Result.Passed)
+	assert.Contains(t, received.Result.File, "config_test.go")
 	require.Len(t, events, 1)
-	assert.Equal(t, axiom.EventTypeAssert, events[0].Type)
+	assert.Equal(t, axiom.EventTypeAssertPassed, events[0].Type)
 	assert.Equal(t, "test", events[0].Message)
 }
 
+func TestConfig_Assert_FailedEventCarriesDiffAndSource(t *testing.T) {
+	var events []axiom.Event
+
+	cfg := &axiom.Config{Runtime: axiom.NewRuntime(
+		axiom.WithRuntimeEventSink(func(e axiom.Event) { events = append(events, e) }),
+	)}
+
+	cfg.Assert(axiom.NewEqualAssert("a\nb", "a\nc", "lines"))
+
+	require.Len(t, events, 1)
+	assert.Equal(t, axiom.EventTypeAssertFailed, events[0].Type)
+	assert.Equal(t, "equal", events[0].Name)
+
+	attrs := map[string]any{}
+	for _, attr := range events[0].Attrs {
+		attrs[attr.Key] = attr.Value
+	}
+	assert.Equal(t, "  a\n- b\n+ c", attrs[axiom.AssertAttrDiff])
+	assert.Contains(t, attrs[axiom.AssertAttrFailure], "not equal")
+	assert.Contains(t, attrs[axiom.AssertAttrSource], "config_test.go:")
+}
+
+func TestConfig_Assert_UsesRuntimeEvaluator(t *testing.T) {
+	var received axiom.Assert
+
+	cfg := &axiom.Config{Runtime: axiom.NewRuntime(
+		axiom.WithRuntimeAssertEvaluator(func(a axiom.Assert) axiom.AssertResult {
+			return axiom.AssertResult{Failure: "custom", File: "custom.go", Line: 7}
+		}),
+		axiom.WithRuntimeAssertSink(func(a axiom.Assert) { received = a }),
+	)}
+
+	cfg.Assert(axiom.NewTrueAssert(true, "ignored by evaluator"))
+
+	assert.True(t, received.Failed())
+	assert.Equal(t, "custom", received.Result.Failure)
+	assert.Equal(t, "custom.go:7", received.Result.Source())
+}
+
 func TestConfig_Artefact_DelegatesToRuntimeSink(t *testing.T) {
 	var received axiom.Artefact
 	var events []axiom.Event
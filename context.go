@@ -3,9 +3,18 @@ package axiom
 import (
 	"context"
 	"fmt"
+	"sync"
 )
 
+// Context is safe for concurrent use through GetContextValue and SetData
+// once normalized; copies share neither the lock nor the data.
+//
+// Case and step timeouts replace Raw, DB, MQ and RPC for their duration.
+// Every branch of ParallelSteps has a Context of its own, so the fields are
+// only replaced by the goroutine running the Config.
 type Context struct {
+	mu *sync.RWMutex
+
 	Raw context.Context
 
 	DB  context.Context
@@ -53,7 +62,9 @@ func WithContextData(key string, value any) ContextOption {
 }
 
 func GetContextValue[T any](c *Context, key string) (T, bool) {
+	unlock := c.rlock()
 	v, ok := c.Data[key]
+	unlock()
 	if !ok {
 		var zero T
 		return zero, false
@@ -72,13 +83,43 @@ func MustContextValue[T any](c *Context, key string) T {
 }
 
 func (c *Context) SetData(key string, value any) {
+	if c.mu != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	}
+
 	if c.Data == nil {
 		c.Data = map[string]any{}
 	}
 	c.Data[key] = value
 }
 
+// swap replaces Raw, DB, MQ and RPC with those of next and returns the
+// previous ones.
+func (c *Context) swap(next Context) Context {
+	if c.mu != nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+	}
+
+	previous := Context{Raw: c.Raw, DB: c.DB, MQ: c.MQ, RPC: c.RPC}
+	c.Raw, c.DB, c.MQ, c.RPC = next.Raw, next.DB, next.MQ, next.RPC
+	return previous
+}
+
+// derive returns a Context sharing the lock and Data of c, with the current
+// Raw, DB, MQ and RPC of c.
+func (c *Context) derive() Context {
+	unlock := c.rlock()
+	defer unlock()
+
+	return Context{mu: c.mu, Raw: c.Raw, DB: c.DB, MQ: c.MQ, RPC: c.RPC, Data: c.Data}
+}
+
 func (c *Context) Copy() Context {
+	unlock := c.rlock()
+	defer unlock()
+
 	result := Context{
 		Raw: c.Raw,
 		DB:  c.DB,
@@ -112,6 +153,8 @@ func (c *Context) Join(other Context) Context {
 		result.RPC = other.RPC
 	}
 
+	unlock := other.rlock()
+	defer unlock()
 	if len(other.Data) > 0 {
 		if result.Data == nil {
 			result.Data = make(map[string]any, len(other.Data))
@@ -125,6 +168,9 @@ func (c *Context) Join(other Context) Context {
 }
 
 func (c *Context) Normalize() {
+	if c.mu == nil {
+		c.mu = &sync.RWMutex{}
+	}
 	if c.Raw == nil {
 		c.Raw = context.Background()
 	}
@@ -141,3 +187,12 @@ func (c *Context) Normalize() {
 		c.Data = map[string]any{}
 	}
 }
+
+func (c *Context) rlock() func() {
+	if c.mu == nil {
+		return func() {}
+	}
+
+	c.mu.RLock()
+	return c.mu.RUnlock
+}
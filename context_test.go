@@ -2,6 +2,8 @@ package axiom_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -214,3 +216,25 @@ func TestContextJoin_BaseNilData_OtherHasData_NoPanicAndMerged(t *testing.T) {
 		assert.Equal(t, "v", joined.Data["k"])
 	})
 }
+
+func TestContext_ConcurrentSetAndGetData(t *testing.T) {
+	r := axiom.NewRunner(axiom.WithRunnerContext(axiom.WithContextData("base", 1)))
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		var wg sync.WaitGroup
+		for i := range 16 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				key := fmt.Sprintf("key-%d", i)
+				cfg.Context.SetData(key, i)
+				assert.Equal(t, i, axiom.MustContextValue[int](&cfg.Context, key))
+				assert.Equal(t, 1, axiom.MustContextValue[int](&cfg.Context, "base"))
+			}()
+		}
+		wg.Wait()
+
+		copied := cfg.Context.Copy()
+		assert.Len(t, copied.Data, 17)
+	})
+}
@@ -2,9 +2,12 @@ package axiom
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 )
 
+var eventSeq atomic.Uint64
+
 type EventType string
 
 const (
@@ -15,13 +18,27 @@ const (
 	EventTypeRunnerAfterAllFinish  EventType = "runner.after-all.finish"
 	EventTypeRunnerAfterAllPanic   EventType = "runner.after-all.panic"
 
-	EventTypeCaseStart  EventType = "case.start"
-	EventTypeCaseFinish EventType = "case.finish"
-	EventTypeCasePanic  EventType = "case.panic"
+	EventTypeCaseStart       EventType = "case.start"
+	EventTypeCaseFinish      EventType = "case.finish"
+	EventTypeCasePanic       EventType = "case.panic"
+	EventTypeCaseTimeout     EventType = "case.timeout"
+	EventTypeCaseQuarantined EventType = "case.quarantined"
+	EventTypeCasePassed      EventType = "case.passed"
+	EventTypeCaseFailed      EventType = "case.failed"
+	EventTypeCaseSkipped     EventType = "case.skipped"
+
+	EventTypeAttemptStart   EventType = "attempt.start"
+	EventTypeAttemptFinish  EventType = "attempt.finish"
+	EventTypeRetryScheduled EventType = "retry.scheduled"
+
+	EventTypeParallelSlotAcquired EventType = "parallel.slot.acquired"
+	EventTypeParallelSlotReleased EventType = "parallel.slot.released"
 
 	EventTypeStepStart      EventType = "step.start"
 	EventTypeStepFinish     EventType = "step.finish"
 	EventTypeStepPanic      EventType = "step.panic"
+	EventTypeStepTimeout    EventType = "step.timeout"
+	EventTypePollAttempt    EventType = "poll.attempt"
 	EventTypeSetupStart     EventType = "setup.start"
 	EventTypeSetupFinish    EventType = "setup.finish"
 	EventTypeSetupPanic     EventType = "setup.panic"
@@ -41,10 +58,21 @@ const (
 	EventTypeResourceCleanupStart  EventType = "resource.cleanup.start"
 	EventTypeResourceCleanupFinish EventType = "resource.cleanup.finish"
 	EventTypeResourceCleanupPanic  EventType = "resource.cleanup.panic"
-
-	EventTypeLog      EventType = "log"
-	EventTypeAssert   EventType = "assert"
-	EventTypeArtefact EventType = "artefact"
+	EventTypeResourceSetupRetry    EventType = "resource.setup.retry"
+	EventTypeResourceReset         EventType = "resource.reset"
+	EventTypeResourceUnavailable   EventType = "resource.unavailable"
+	EventTypeResourceLeaseAcquired EventType = "resource.lease.acquired"
+	EventTypeResourceLeaseReleased EventType = "resource.lease.released"
+	EventTypeResourceLeaseTimeout  EventType = "resource.lease.timeout"
+	EventTypeResourceWarmUpStart   EventType = "resource.warmup.start"
+	EventTypeResourceWarmUpFinish  EventType = "resource.warmup.finish"
+	EventTypeResourceWarmUpFailed  EventType = "resource.warmup.failed"
+
+	EventTypeLog          EventType = "log"
+	EventTypeAssert       EventType = "assert"
+	EventTypeAssertPassed EventType = "assert.passed"
+	EventTypeAssertFailed EventType = "assert.failed"
+	EventTypeArtefact     EventType = "artefact"
 )
 
 func (t EventType) String() string {
@@ -52,10 +80,19 @@ func (t EventType) String() string {
 }
 
 type Event struct {
-	Time    string    `json:"time,omitempty"`
-	Name    string    `json:"name,omitempty"`
-	Type    EventType `json:"type"`
-	Message string    `json:"message,omitempty"`
+	Seq      uint64        `json:"seq,omitempty"`
+	Time     string        `json:"time,omitempty"`
+	Name     string        `json:"name,omitempty"`
+	Type     EventType     `json:"type"`
+	Message  string        `json:"message,omitempty"`
+	Attrs    []Attr        `json:"attrs,omitempty"`
+	ID       string        `json:"id,omitempty"`
+	ParentID string        `json:"parent_id,omitempty"`
+	CaseID   string        `json:"case_id,omitempty"`
+	CaseName string        `json:"case_name,omitempty"`
+	Attempt  int           `json:"attempt,omitempty"`
+	Steps    []string      `json:"steps,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
 }
 
 type EventOption func(*Event)
@@ -82,19 +119,55 @@ func WithEventMessage(message any) EventOption {
 	return func(e *Event) { e.Message = fmt.Sprint(message) }
 }
 
+func WithEventAttrs(attrs ...Attr) EventOption {
+	return func(e *Event) { e.Attrs = append(e.Attrs, attrs...) }
+}
+
+func WithEventSpan(id, parentID string) EventOption {
+	return func(e *Event) {
+		e.ID = id
+		e.ParentID = parentID
+	}
+}
+
+func WithEventDuration(d time.Duration) EventOption {
+	return func(e *Event) { e.Duration = d }
+}
+
 func NewLogEvent(l Log) Event {
 	return NewEvent(
 		EventTypeLog,
 		WithEventName(l.Level.String()),
 		WithEventMessage(l.Text),
+		WithEventAttrs(l.Attrs...),
 	)
 }
 
+// NewAssertEvent reports evaluated assertions as assert.passed or
+// assert.failed; assertions without a result stay plain assert events.
 func NewAssertEvent(a Assert) Event {
+	eventType := EventTypeAssert
+	var attrs []Attr
+
+	if a.Result != nil {
+		eventType = EventTypeAssertPassed
+		if !a.Result.Passed {
+			eventType = EventTypeAssertFailed
+			attrs = append(attrs, NewAttr(AssertAttrFailure, a.Result.Failure))
+			if a.Result.Diff != "" {
+				attrs = append(attrs, NewAttr(AssertAttrDiff, a.Result.Diff))
+			}
+		}
+		if source := a.Result.Source(); source != "" {
+			attrs = append(attrs, NewAttr(AssertAttrSource, source))
+		}
+	}
+
 	return NewEvent(
-		EventTypeAssert,
+		eventType,
 		WithEventName(a.Type.String()),
 		WithEventMessage(a.Message),
+		WithEventAttrs(attrs...),
 	)
 }
 
@@ -106,7 +179,13 @@ func NewArtefactEvent(a Artefact) Event {
 	)
 }
 
+// Normalize fills the time and the sequence number. Sequence numbers are
+// process-wide and strictly increasing, so they order events of parallel
+// cases even when their timestamps are equal.
 func (e *Event) Normalize() {
+	if e.Seq == 0 {
+		e.Seq = eventSeq.Add(1)
+	}
 	if e.Time == "" {
 		e.Time = time.Now().Format(time.RFC3339Nano)
 	}
@@ -124,3 +124,23 @@ func requireEventTypes(t *testing.T, events []axiom.Event, types ...axiom.EventT
 		assert.Equal(t, eventType, events[i].Type)
 	}
 }
+
+func TestNewEvent_AssignsIncreasingSequence(t *testing.T) {
+	first := axiom.NewEvent(axiom.EventTypeLog)
+	second := axiom.NewEvent(axiom.EventTypeLog)
+
+	assert.NotZero(t, first.Seq)
+	assert.Greater(t, second.Seq, first.Seq)
+}
+
+func TestNewEvent_WithSpanAndDuration(t *testing.T) {
+	e := axiom.NewEvent(
+		axiom.EventTypeStepFinish,
+		axiom.WithEventSpan("2", "1"),
+		axiom.WithEventDuration(time.Second),
+	)
+
+	assert.Equal(t, "2", e.ID)
+	assert.Equal(t, "1", e.ParentID)
+	assert.Equal(t, time.Second, e.Duration)
+}
@@ -1,5 +1,13 @@
 package axiom
 
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
 type Fixture func(cfg *Config) (any, func(), error)
 
 type FixtureResult struct {
@@ -11,6 +19,8 @@ type FixtureCleanup func(*Config)
 
 type Fixtures struct {
 	Registry map[string]Fixture
+	Deps     map[string][]string
+	Scopes   map[string]FixtureScope
 	Cache    map[string]FixtureResult
 	Cleanups []FixtureCleanup
 }
@@ -46,6 +56,17 @@ func WithFixturesMap(fixtures map[string]Fixture) FixturesOption {
 	}
 }
 
+// WithFixtureDeps declares fixtures that must be set up before name. They are
+// resolved in dependency order and therefore cleaned up after name.
+func WithFixtureDeps(name string, deps ...string) FixturesOption {
+	return func(f *Fixtures) {
+		if f.Deps == nil {
+			f.Deps = map[string][]string{}
+		}
+		f.Deps[name] = append(f.Deps[name], deps...)
+	}
+}
+
 func (f *Fixtures) Copy() Fixtures {
 	result := Fixtures{}
 
@@ -55,6 +76,18 @@ func (f *Fixtures) Copy() Fixtures {
 			result.Registry[k] = v
 		}
 	}
+	if f.Deps != nil {
+		result.Deps = make(map[string][]string, len(f.Deps))
+		for k, v := range f.Deps {
+			result.Deps[k] = append([]string{}, v...)
+		}
+	}
+	if f.Scopes != nil {
+		result.Scopes = make(map[string]FixtureScope, len(f.Scopes))
+		for k, v := range f.Scopes {
+			result.Scopes[k] = v
+		}
+	}
 	if f.Cache != nil {
 		result.Cache = make(map[string]FixtureResult, len(f.Cache))
 		for k, v := range f.Cache {
@@ -76,6 +109,18 @@ func (f *Fixtures) Join(other Fixtures) Fixtures {
 	for k, v := range other.Registry {
 		result.Registry[k] = v
 	}
+	if len(other.Deps) > 0 && result.Deps == nil {
+		result.Deps = map[string][]string{}
+	}
+	for k, v := range other.Deps {
+		result.Deps[k] = append([]string{}, v...)
+	}
+	if len(other.Scopes) > 0 && result.Scopes == nil {
+		result.Scopes = map[string]FixtureScope{}
+	}
+	for k, v := range other.Scopes {
+		result.Scopes[k] = v
+	}
 	result.Cache = map[string]FixtureResult{}
 	result.Cleanups = nil
 
@@ -86,11 +131,88 @@ func (f *Fixtures) Normalize() {
 	if f.Registry == nil {
 		f.Registry = map[string]Fixture{}
 	}
+	if f.Deps == nil {
+		f.Deps = map[string][]string{}
+	}
+	if f.Scopes == nil {
+		f.Scopes = map[string]FixtureScope{}
+	}
 	if f.Cache == nil {
 		f.Cache = map[string]FixtureResult{}
 	}
 }
 
+// Validate checks the declared dependency graph: every fixture with declared
+// dependencies and every dependency must be registered, a fixture may only
+// depend on fixtures that live at least as long, and the graph must be
+// acyclic.
+func (f *Fixtures) Validate() error {
+	for _, name := range sortedKeys(f.Scopes) {
+		if !f.Scopes[name].valid() {
+			return fmt.Errorf("fixture %q has unknown scope %q", name, f.Scopes[name])
+		}
+	}
+
+	for _, name := range sortedKeys(f.Deps) {
+		if _, ok := f.Registry[name]; !ok {
+			return fmt.Errorf("fixture %q declares dependencies but is not registered", name)
+		}
+		for _, dep := range f.Deps[name] {
+			if _, ok := f.Registry[dep]; !ok {
+				return fmt.Errorf("fixture %q depends on unknown fixture %q", name, dep)
+			}
+			if f.Scope(dep).rank() < f.Scope(name).rank() {
+				return fmt.Errorf("fixture %q with scope %q depends on fixture %q with narrower scope %q",
+					name, f.Scope(name), dep, f.Scope(dep))
+			}
+		}
+	}
+
+	_, err := f.Order(sortedKeys(f.Registry)...)
+	return err
+}
+
+// Order returns names together with their declared dependencies so that
+// every fixture comes after the fixtures it depends on.
+func (f *Fixtures) Order(names ...string) ([]string, error) {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	var order []string
+	state := map[string]int{}
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fixtureCycleError(append(path, name))
+		}
+
+		state[name] = visiting
+		for _, dep := range f.Deps[name] {
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
+
 func (f *Fixtures) Teardown(cfg *Config) {
 	for i := len(f.Cleanups) - 1; i >= 0; i-- {
 		f.Cleanups[i](cfg)
@@ -105,50 +227,217 @@ func GetFixture[T any](cfg *Config, name string) T {
 		panic("fixture: nil config")
 	}
 
-	if res, ok := cfg.Fixtures.Cache[name]; ok {
-		out, ok := res.Value.(T)
-		if !ok {
-			cfg.Event(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("unexpected type")))
-			cfg.SubT.Fatalf("fixture %q has unexpected type", name)
-			return zero
-		}
-		return out
-	}
-
-	fx, ok := cfg.Fixtures.Registry[name]
+	val, ok := cfg.resolveFixture(name, func(v any) bool {
+		_, ok := v.(T)
+		return ok || v == nil
+	})
 	if !ok {
-		cfg.Event(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("not found")))
-		cfg.SubT.Fatalf("fixture %q not found", name)
 		return zero
 	}
-	if fx == nil {
-		cfg.Event(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("nil fixture")))
-		cfg.SubT.Fatalf("fixture %q is nil", name)
+
+	out, ok := val.(T)
+	if !ok && val != nil {
+		cfg.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("unexpected type")))
+		cfg.Fatalf("fixture %q has unexpected type", name)
 		return zero
 	}
 
-	cfg.Event(NewEvent(EventTypeFixtureSetupStart, WithEventName(name)))
-	val, cleanup, err := fx(cfg)
+	return out
+}
+
+// fixtureCall is a fixture being set up for an attempt; other requests for it
+// wait for its result. waiting names the fixture its setup is blocked on.
+type fixtureCall struct {
+	done    chan struct{}
+	waiting string
+	value   any
+	err     error
+}
+
+// resolveFixture returns the value of name, setting it up and its declared
+// dependencies on a cache miss. Concurrent calls for the same fixture set it up
+// once, and a new value is cached only when accepted by typed. Failures are
+// reported through Fatalf and return false.
+func (c *Config) resolveFixture(name string, typed func(any) bool) (any, bool) {
+	root := c.root()
+
+	root.fixtureMu.Lock()
+	if res, ok := root.Fixtures.Cache[name]; ok {
+		root.fixtureMu.Unlock()
+		return res.Value, true
+	}
+
+	fx, ok := root.Fixtures.Registry[name]
+	switch {
+	case !ok:
+		root.fixtureMu.Unlock()
+		c.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("not found")))
+		c.Fatalf("fixture %q not found", name)
+		return nil, false
+	case fx == nil:
+		root.fixtureMu.Unlock()
+		c.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("nil fixture")))
+		c.Fatalf("fixture %q is nil", name)
+		return nil, false
+	}
+
+	if cycle := root.fixtureCycleLocked(c.resolving, name); cycle != nil {
+		root.fixtureMu.Unlock()
+		c.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("dependency cycle")))
+		c.Fatalf("%v", fixtureCycleError(cycle))
+		return nil, false
+	}
+
+	if n := len(c.resolving); n > 0 {
+		if requester, ok := root.fixtureCalls[c.resolving[n-1]]; ok {
+			requester.waiting = name
+			defer func() {
+				root.fixtureMu.Lock()
+				requester.waiting = ""
+				root.fixtureMu.Unlock()
+			}()
+		}
+	}
+
+	if call, ok := root.fixtureCalls[name]; ok {
+		root.fixtureMu.Unlock()
+		return c.waitFixture(name, call)
+	}
+
+	call := &fixtureCall{done: make(chan struct{})}
+	if root.fixtureCalls == nil {
+		root.fixtureCalls = map[string]*fixtureCall{}
+	}
+	root.fixtureCalls[name] = call
+	root.fixtureMu.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed && call.err == nil {
+			call.err = fmt.Errorf("setup of fixture %q did not complete", name)
+		}
+
+		root.fixtureMu.Lock()
+		delete(root.fixtureCalls, name)
+		root.fixtureMu.Unlock()
+
+		close(call.done)
+	}()
+
+	view := c.fixtureView(name)
+	for _, dep := range root.Fixtures.Deps[name] {
+		if _, ok := view.resolveFixture(dep, func(any) bool { return true }); !ok {
+			return nil, false
+		}
+	}
+
+	start := time.Now()
+	val, cleanup, created, err := view.setupFixture(name, fx)
 	if err != nil {
-		cfg.Event(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage(err.Error())))
-		cfg.SubT.Fatalf("fixture %q failed: %v", name, err)
-		return zero
+		call.err = err
+		c.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage(err.Error())))
+		c.Fatalf("fixture %q failed: %v", name, err)
+		return nil, false
+	}
+	if !typed(val) {
+		call.err = fmt.Errorf("fixture %q has unexpected type", name)
+		c.emit(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("unexpected type")))
+		c.Fatalf("%v", call.err)
+		return nil, false
 	}
 
-	if cleanup != nil {
-		cfg.Fixtures.Cleanups = append(cfg.Fixtures.Cleanups, fixtureCleanupHook(name, cleanup))
+	root.fixtureMu.Lock()
+	root.Fixtures.Cache[name] = FixtureResult{Value: val, Cleanup: cleanup}
+	root.fixtureMu.Unlock()
+
+	call.value = val
+	completed = true
+	if created {
+		c.emit(NewEvent(EventTypeFixtureSetupFinish, WithEventName(name), WithEventDuration(time.Since(start))))
 	}
 
-	out, ok := val.(T)
-	if !ok {
-		cfg.Event(NewEvent(EventTypeFixtureSetupFailed, WithEventName(name), WithEventMessage("unexpected type")))
-		cfg.SubT.Fatalf("fixture %q has unexpected type", name)
-		return zero
+	return val, true
+}
+
+// waitFixture waits for the setup of name requested elsewhere.
+func (c *Config) waitFixture(name string, call *fixtureCall) (any, bool) {
+	<-call.done
+
+	if call.err != nil {
+		c.Fatalf("fixture %q failed: %v", name, call.err)
+		return nil, false
 	}
-	cfg.Fixtures.Cache[name] = FixtureResult{Value: val, Cleanup: cleanup}
-	cfg.Event(NewEvent(EventTypeFixtureSetupFinish, WithEventName(name)))
 
-	return out
+	return call.value, true
+}
+
+// fixtureView returns the Config passed to the setup of name. It carries the
+// chain of fixtures being set up, so that a fixture requested again further
+// down its own setup is reported as a cycle.
+func (c *Config) fixtureView(name string) *Config {
+	view := c.derive()
+	view.resolving = append(slices.Clone(c.resolving), name)
+
+	return view
+}
+
+// fixtureCycleLocked returns the cycle that setting up name at the end of
+// path would close: either name is on path already, or waiting for name would
+// wait for a fixture on path through the setups name is blocked on.
+func (c *Config) fixtureCycleLocked(path []string, name string) []string {
+	if i := slices.Index(path, name); i >= 0 {
+		return append(slices.Clone(path[i:]), name)
+	}
+	if len(path) == 0 {
+		return nil
+	}
+
+	chain := []string{name}
+	for waited := name; ; {
+		call, ok := c.fixtureCalls[waited]
+		if !ok || call.waiting == "" || slices.Contains(chain, call.waiting) {
+			return nil
+		}
+
+		waited = call.waiting
+		if i := slices.Index(path, waited); i >= 0 {
+			return append(append(slices.Clone(path[i:]), chain...), waited)
+		}
+		chain = append(chain, waited)
+	}
+}
+
+func fixtureCycleError(path []string) error {
+	for i, name := range path[:len(path)-1] {
+		if name == path[len(path)-1] {
+			path = path[i:]
+			break
+		}
+	}
+
+	return fmt.Errorf("fixture dependency cycle: %s", strings.Join(path, " -> "))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// setupDeclaredFixtures sets up every fixture of the declared dependency graph
+// in dependency order before the BeforeTest hooks run.
+func (c *Config) setupDeclaredFixtures() {
+	order, err := c.Fixtures.Order(sortedKeys(c.Fixtures.Deps)...)
+	if err != nil {
+		c.Fatalf("invalid fixtures: %v", err)
+	}
+	for _, name := range order {
+		GetFixture[any](c, name)
+	}
 }
 
 func UseFixtures(names ...string) func(cfg *Config) {
@@ -161,14 +450,15 @@ func UseFixtures(names ...string) func(cfg *Config) {
 
 func fixtureCleanupHook(name string, cleanup func()) FixtureCleanup {
 	return func(c *Config) {
-		c.Event(NewEvent(EventTypeFixtureCleanupStart, WithEventName(name)))
+		start := time.Now()
+		c.emit(NewEvent(EventTypeFixtureCleanupStart, WithEventName(name)))
 		defer func() {
 			if v := recover(); v != nil {
-				c.Event(NewEvent(EventTypeFixtureCleanupPanic, WithEventName(name), WithEventMessage(v)))
+				c.emit(NewEvent(EventTypeFixtureCleanupPanic, WithEventName(name), WithEventMessage(v)))
 				panic(v)
 			}
 
-			c.Event(NewEvent(EventTypeFixtureCleanupFinish, WithEventName(name)))
+			c.emit(NewEvent(EventTypeFixtureCleanupFinish, WithEventName(name), WithEventDuration(time.Since(start))))
 		}()
 
 		cleanup()
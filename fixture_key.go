@@ -0,0 +1,51 @@
+package axiom
+
+import "fmt"
+
+// FixtureKey names a fixture together with the type of its value, so the
+// fixture is registered and retrieved with the same type.
+type FixtureKey[T any] struct {
+	name string
+}
+
+type TypedFixture[T any] func(cfg *Config) (T, func(), error)
+
+func NewFixtureKey[T any](name string) FixtureKey[T] {
+	if name == "" {
+		panic("fixture: key name must not be empty")
+	}
+
+	return FixtureKey[T]{name: name}
+}
+
+func (k FixtureKey[T]) Name() string {
+	return k.name
+}
+
+// Fixture adapts fx to the string-keyed Fixture registry.
+func (k FixtureKey[T]) Fixture(fx TypedFixture[T]) Fixture {
+	k.validate()
+	if fx == nil {
+		panic(fmt.Sprintf("fixture: nil fixture for key %q", k.name))
+	}
+
+	return func(cfg *Config) (any, func(), error) {
+		return fx(cfg)
+	}
+}
+
+// Get returns the value of the fixture with the semantics of GetFixture.
+func (k FixtureKey[T]) Get(cfg *Config) T {
+	k.validate()
+	return GetFixture[T](cfg, k.name)
+}
+
+func (k FixtureKey[T]) validate() {
+	if k.name == "" {
+		panic("fixture: key must be created with NewFixtureKey")
+	}
+}
+
+func WithFixtureKey[T any](key FixtureKey[T], fx TypedFixture[T]) FixturesOption {
+	return WithFixture(key.Name(), key.Fixture(fx))
+}
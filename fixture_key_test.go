@@ -0,0 +1,97 @@
+package axiom_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+)
+
+type fixtureUser struct {
+	Name string
+}
+
+type fixtureStore interface {
+	Name() string
+}
+
+func TestNewFixtureKey_EmptyName_Panics(t *testing.T) {
+	assert.PanicsWithValue(t, "fixture: key name must not be empty", func() {
+		axiom.NewFixtureKey[int]("")
+	})
+}
+
+func TestFixtureKey_ZeroKey_Panics(t *testing.T) {
+	assert.PanicsWithValue(t, "fixture: key must be created with NewFixtureKey", func() {
+		axiom.FixtureKey[int]{}.Get(&axiom.Config{})
+	})
+}
+
+func TestFixtureKey_RegistersAndReturnsTypedValue(t *testing.T) {
+	userKey := axiom.NewFixtureKey[fixtureUser]("user")
+	cleaned := false
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixtureKey(userKey, func(cfg *axiom.Config) (fixtureUser, func(), error) {
+			return fixtureUser{Name: "alice"}, func() { cleaned = true }, nil
+		}),
+	)
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		assert.Equal(t, fixtureUser{Name: "alice"}, userKey.Get(cfg))
+		assert.Equal(t, fixtureUser{Name: "alice"}, axiom.GetFixture[fixtureUser](cfg, "user"))
+	})
+
+	assert.True(t, cleaned)
+}
+
+func TestFixtureKey_CaseFixtureOverridesRunnerFixture(t *testing.T) {
+	key := axiom.NewFixtureKey[int]("count")
+
+	r := axiom.NewRunner(axiom.WithRunnerFixtureKey(key, func(cfg *axiom.Config) (int, func(), error) {
+		return 1, nil, nil
+	}))
+	c := axiom.NewCase(axiom.WithCaseFixtureKey(key, func(cfg *axiom.Config) (int, func(), error) {
+		return 2, nil, nil
+	}))
+
+	r.RunCase(t, c, func(cfg *axiom.Config) {
+		assert.Equal(t, 2, key.Get(cfg))
+	})
+}
+
+func TestFixtureKey_NilInterfaceValue_ReturnsZero(t *testing.T) {
+	key := axiom.NewFixtureKey[fixtureStore]("store")
+	cfg := &axiom.Config{Fixtures: axiom.NewFixtures(
+		axiom.WithFixtureKey(key, func(cfg *axiom.Config) (fixtureStore, func(), error) {
+			return nil, nil, nil
+		}),
+	)}
+	cfg.Fixtures.Normalize()
+
+	assert.Nil(t, key.Get(cfg))
+}
+
+func TestFixtureKey_FactoryError_FailsTest(t *testing.T) {
+	key := axiom.NewFixtureKey[int]("count")
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Fixtures: axiom.NewFixtures(axiom.WithFixtureKey(key, func(cfg *axiom.Config) (int, func(), error) {
+			return 0, nil, errors.New("boom")
+		})),
+	}
+	cfg.Fixtures.Normalize()
+
+	runFixtureFatal(func() { _ = key.Get(cfg) })
+
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{`fixture "count" failed: boom`}, cfg.Failures())
+}
+
+func TestFixtureKey_NilFixture_Panics(t *testing.T) {
+	assert.PanicsWithValue(t, `fixture: nil fixture for key "count"`, func() {
+		axiom.NewFixtureKey[int]("count").Fixture(nil)
+	})
+}
@@ -0,0 +1,301 @@
+package axiom
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+type FixtureScope string
+
+const (
+	FixtureScopeAttempt FixtureScope = "attempt"
+	FixtureScopeCase    FixtureScope = "case"
+	FixtureScopeSuite   FixtureScope = "suite"
+	FixtureScopeRunner  FixtureScope = "runner"
+)
+
+func (s FixtureScope) String() string {
+	return string(s)
+}
+
+func (s FixtureScope) valid() bool {
+	return s.rank() >= 0
+}
+
+func (s FixtureScope) rank() int {
+	switch s {
+	case FixtureScopeAttempt, "":
+		return 0
+	case FixtureScopeCase:
+		return 1
+	case FixtureScopeSuite:
+		return 2
+	case FixtureScopeRunner:
+		return 3
+	}
+
+	return -1
+}
+
+// WithFixtureScope sets how long the value of a fixture is shared: a single
+// attempt (default), all attempts of a case, all cases of a suite or test
+// group, or the whole runner.
+func WithFixtureScope(name string, scope FixtureScope) FixturesOption {
+	return func(f *Fixtures) {
+		if f.Scopes == nil {
+			f.Scopes = map[string]FixtureScope{}
+		}
+		f.Scopes[name] = scope
+	}
+}
+
+func (f *Fixtures) Scope(name string) FixtureScope {
+	if scope, ok := f.Scopes[name]; ok && scope != "" {
+		return scope
+	}
+
+	return FixtureScopeAttempt
+}
+
+type fixtureStore struct {
+	mu      sync.Mutex
+	t       *testing.T
+	entries map[string]*fixtureEntry
+	config  *Config
+}
+
+type fixtureEntry struct {
+	done  chan struct{}
+	value any
+	err   error
+}
+
+func newFixtureStore(t *testing.T) *fixtureStore {
+	return &fixtureStore{t: t, entries: map[string]*fixtureEntry{}}
+}
+
+// get returns the shared value of name, running setup at most once at a
+// time. Failed setups are not cached, so a later attempt tries again.
+func (s *fixtureStore) get(name string, setup func() (any, func(), error)) (value any, created bool, err error) {
+	s.mu.Lock()
+	if entry, ok := s.entries[name]; ok {
+		s.mu.Unlock()
+		<-entry.done
+		return entry.value, false, entry.err
+	}
+
+	entry := &fixtureEntry{done: make(chan struct{})}
+	s.entries[name] = entry
+	s.mu.Unlock()
+
+	completed := false
+	defer func() {
+		if !completed {
+			entry.err = fmt.Errorf("setup of fixture %q did not complete", name)
+		}
+		if entry.err != nil {
+			s.mu.Lock()
+			delete(s.entries, name)
+			s.mu.Unlock()
+		}
+		close(entry.done)
+	}()
+
+	entry.value, _, entry.err = setup()
+	completed = true
+
+	return entry.value, true, entry.err
+}
+
+// configFor returns the Config that sets up and cleans up the values of s,
+// building it from c on first use. It lives as long as the scope: it carries
+// the context of the runner and case without any attempt timeout, and the
+// testing.T of the scope instead of the one of the attempt. Cleanups and
+// leases registered while a value is set up are released with the store.
+func (s *fixtureStore) configFor(c *Config, scope FixtureScope) *Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.config != nil {
+		return s.config
+	}
+
+	cfg := &Config{
+		RootT:   s.t,
+		Runner:  c.Runner,
+		Case:    c.Case,
+		Meta:    c.Meta,
+		Context: c.Runner.Context.Copy(),
+		Runtime: c.Runner.Runtime.Copy(),
+		Fixtures: Fixtures{
+			Registry: c.Fixtures.Registry,
+			Deps:     c.Fixtures.Deps,
+			Scopes:   c.Fixtures.Scopes,
+		},
+		Quarantine: c.Quarantine,
+		Soft:       c.Soft,
+	}
+	if scope == FixtureScopeCase {
+		cfg.Context = cfg.Context.Join(c.Case.Context)
+		cfg.Runtime = cfg.Runtime.Join(c.Case.Runtime)
+		cfg.caseFixtures = s
+	}
+	cfg.Context.Normalize()
+	cfg.Fixtures.Normalize()
+
+	s.config = cfg
+	return cfg
+}
+
+func (s *fixtureStore) teardown() {
+	s.mu.Lock()
+	cfg := s.config
+	s.config = nil
+	s.entries = map[string]*fixtureEntry{}
+	s.mu.Unlock()
+
+	if cfg != nil {
+		cfg.fixtureMu.Lock()
+		cleanups := cfg.Fixtures.Cleanups
+		cfg.Fixtures.Cleanups = nil
+		cfg.fixtureMu.Unlock()
+
+		for i := len(cleanups) - 1; i >= 0; i-- {
+			cleanups[i](cfg)
+		}
+	}
+}
+
+// setupFixture runs the factory of an uncached fixture. Values of wider
+// scopes come from the shared store and are set up with the Config of the
+// store instead of the attempt, so they outlive it.
+func (c *Config) setupFixture(name string, fx Fixture) (any, func(), bool, error) {
+	scope := c.Fixtures.Scope(name)
+	store := c.fixtureStore(scope)
+
+	create := func() (any, func(), error) {
+		c.emit(NewEvent(EventTypeFixtureSetupStart, WithEventName(name)))
+
+		factory := c
+		if store != nil {
+			factory = store.configFor(c, scope).derive()
+			factory.resolving = c.resolving
+		}
+
+		val, cleanup, err := fx(factory)
+		if err == nil && cleanup != nil {
+			root := factory.root()
+			root.fixtureMu.Lock()
+			root.Fixtures.Cleanups = append(root.Fixtures.Cleanups, fixtureCleanupHook(name, cleanup))
+			root.fixtureMu.Unlock()
+		}
+		return val, cleanup, err
+	}
+
+	if store == nil {
+		val, cleanup, err := create()
+		if err != nil {
+			return nil, nil, true, err
+		}
+		return val, cleanup, true, nil
+	}
+
+	val, created, err := store.get(name, create)
+	return val, nil, created, err
+}
+
+// fixtureStore returns the shared store of scope, or nil when values live in
+// the attempt, including configs built outside of a runner.
+func (c *Config) fixtureStore(scope FixtureScope) *fixtureStore {
+	switch scope {
+	case FixtureScopeCase:
+		return c.root().caseFixtures
+	case FixtureScopeSuite:
+		if c.Runner != nil && c.RootT != nil {
+			return c.Runner.groupFixtures(c.RootT)
+		}
+	case FixtureScopeRunner:
+		if c.Runner != nil {
+			return c.Runner.runnerFixtures()
+		}
+	}
+
+	return nil
+}
+
+type fixtureGroups struct {
+	mu      sync.Mutex
+	members map[*testing.T]*testing.T
+	stores  map[*testing.T]*fixtureStore
+	runner  *fixtureStore
+}
+
+// bindFixtureGroup makes cases run on t share suite-scoped fixtures of group.
+// Without a binding every test is its own group.
+func (r *Runner) bindFixtureGroup(t, group *testing.T) {
+	r.fixtureGroups.mu.Lock()
+	defer r.fixtureGroups.mu.Unlock()
+
+	if r.fixtureGroups.members == nil {
+		r.fixtureGroups.members = map[*testing.T]*testing.T{}
+	}
+	r.fixtureGroups.members[t] = group
+
+	t.Cleanup(func() {
+		r.fixtureGroups.mu.Lock()
+		defer r.fixtureGroups.mu.Unlock()
+		delete(r.fixtureGroups.members, t)
+	})
+}
+
+func (r *Runner) groupFixtures(t *testing.T) *fixtureStore {
+	r.fixtureGroups.mu.Lock()
+	defer r.fixtureGroups.mu.Unlock()
+
+	group := t
+	if bound, ok := r.fixtureGroups.members[t]; ok {
+		group = bound
+	}
+
+	if store, ok := r.fixtureGroups.stores[group]; ok {
+		return store
+	}
+	if r.fixtureGroups.stores == nil {
+		r.fixtureGroups.stores = map[*testing.T]*fixtureStore{}
+	}
+
+	store := newFixtureStore(group)
+	r.fixtureGroups.stores[group] = store
+	group.Cleanup(func() {
+		r.fixtureGroups.mu.Lock()
+		delete(r.fixtureGroups.stores, group)
+		r.fixtureGroups.mu.Unlock()
+
+		store.teardown()
+	})
+
+	return store
+}
+
+func (r *Runner) runnerFixtures() *fixtureStore {
+	r.fixtureGroups.mu.Lock()
+	defer r.fixtureGroups.mu.Unlock()
+
+	if r.fixtureGroups.runner == nil {
+		r.fixtureGroups.runner = newFixtureStore(nil)
+	}
+
+	return r.fixtureGroups.runner
+}
+
+func (r *Runner) teardownRunnerFixtures() {
+	r.fixtureGroups.mu.Lock()
+	store := r.fixtureGroups.runner
+	r.fixtureGroups.runner = nil
+	r.fixtureGroups.mu.Unlock()
+
+	if store != nil {
+		store.teardown()
+	}
+}
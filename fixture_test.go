@@ -1,11 +1,16 @@
 package axiom_test
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // helper to extract a fixture value without full config
@@ -672,3 +677,470 @@ func TestFixturesCopy_DeepCopyMaps(t *testing.T) {
 	assert.NotContains(t, f.Registry, "y")
 	assert.NotContains(t, f.Cache, "y")
 }
+
+func TestFixtures_JoinMergesDeps(t *testing.T) {
+	base := axiom.NewFixtures(axiom.WithFixtureDeps("user", "db"))
+	other := axiom.NewFixtures(axiom.WithFixtureDeps("session", "user"))
+
+	joined := base.Join(other)
+
+	assert.Equal(t, map[string][]string{"user": {"db"}, "session": {"user"}}, joined.Deps)
+
+	joined.Deps["user"][0] = "changed"
+	assert.Equal(t, []string{"db"}, base.Deps["user"])
+}
+
+func TestFixtures_Validate(t *testing.T) {
+	noop := func(cfg *axiom.Config) (any, func(), error) { return nil, nil, nil }
+
+	tests := []struct {
+		name     string
+		fixtures axiom.Fixtures
+		err      string
+	}{
+		{
+			name: "valid",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixturesMap(map[string]axiom.Fixture{"db": noop, "user": noop}),
+				axiom.WithFixtureDeps("user", "db"),
+			),
+		},
+		{
+			name: "unknown dependency",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixture("user", noop),
+				axiom.WithFixtureDeps("user", "db"),
+			),
+			err: `fixture "user" depends on unknown fixture "db"`,
+		},
+		{
+			name: "unregistered fixture",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixture("db", noop),
+				axiom.WithFixtureDeps("user", "db"),
+			),
+			err: `fixture "user" declares dependencies but is not registered`,
+		},
+		{
+			name: "cycle",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixturesMap(map[string]axiom.Fixture{"a": noop, "b": noop, "c": noop}),
+				axiom.WithFixtureDeps("a", "b"),
+				axiom.WithFixtureDeps("b", "c"),
+				axiom.WithFixtureDeps("c", "b"),
+			),
+			err: "fixture dependency cycle: b -> c -> b",
+		},
+		{
+			name: "unknown scope",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixture("db", noop),
+				axiom.WithFixtureScope("db", "process"),
+			),
+			err: `fixture "db" has unknown scope "process"`,
+		},
+		{
+			name: "narrower dependency",
+			fixtures: axiom.NewFixtures(
+				axiom.WithFixturesMap(map[string]axiom.Fixture{"db": noop, "user": noop}),
+				axiom.WithFixtureDeps("db", "user"),
+				axiom.WithFixtureScope("db", axiom.FixtureScopeSuite),
+				axiom.WithFixtureScope("user", axiom.FixtureScopeCase),
+			),
+			err: `fixture "db" with scope "suite" depends on fixture "user" with narrower scope "case"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fixtures.Validate()
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestFixtures_Order(t *testing.T) {
+	f := axiom.NewFixtures(
+		axiom.WithFixtureDeps("session", "user", "token"),
+		axiom.WithFixtureDeps("user", "db"),
+		axiom.WithFixtureDeps("token", "db"),
+	)
+
+	order, err := f.Order("session")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"db", "user", "token", "session"}, order)
+}
+
+func TestGetFixture_ResolvesDeclaredDepsFirst(t *testing.T) {
+	var order []string
+	fixture := func(name string) axiom.Fixture {
+		return func(cfg *axiom.Config) (any, func(), error) {
+			order = append(order, "setup "+name)
+			return name, func() { order = append(order, "cleanup "+name) }, nil
+		}
+	}
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("db", fixture("db")),
+		axiom.WithRunnerFixture("cache", fixture("cache")),
+		axiom.WithRunnerFixture("user", fixture("user")),
+		axiom.WithRunnerFixtureDeps("user", "db", "cache"),
+	)
+	c := axiom.NewCase()
+	cfg := r.BuildConfig(t, &c)
+
+	cfg.Test(func(cfg *axiom.Config) {
+		assert.Equal(t, "user", axiom.GetFixture[string](cfg, "user"))
+	})
+
+	assert.Equal(t, []string{
+		"setup db", "setup cache", "setup user",
+		"cleanup user", "cleanup cache", "cleanup db",
+	}, order)
+}
+
+func TestConfig_Test_SetsUpDeclaredFixturesBeforeBeforeTest(t *testing.T) {
+	var order []string
+	fixture := func(name string) axiom.Fixture {
+		return func(cfg *axiom.Config) (any, func(), error) {
+			order = append(order, "setup "+name)
+			return name, func() { order = append(order, "cleanup "+name) }, nil
+		}
+	}
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("db", fixture("db")),
+		axiom.WithRunnerFixture("cache", fixture("cache")),
+		axiom.WithRunnerFixture("user", fixture("user")),
+		axiom.WithRunnerFixture("session", fixture("session")),
+		axiom.WithRunnerFixture("unused", fixture("unused")),
+		axiom.WithRunnerFixtureDeps("user", "db"),
+		axiom.WithRunnerFixtureDeps("session", "user", "cache"),
+		axiom.WithRunnerHooks(axiom.WithBeforeTest(func(cfg *axiom.Config) {
+			order = append(order, "before test")
+		})),
+	)
+	c := axiom.NewCase()
+	cfg := r.BuildConfig(t, &c)
+
+	cfg.Test(func(cfg *axiom.Config) {
+		order = append(order, "test")
+	})
+
+	assert.Equal(t, []string{
+		"setup db", "setup user", "setup cache", "setup session", "before test", "test",
+		"cleanup session", "cleanup cache", "cleanup user", "cleanup db",
+	}, order)
+}
+
+func TestGetFixture_DetectsRuntimeCycle(t *testing.T) {
+	var events []axiom.Event
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Fixtures: axiom.NewFixtures(axiom.WithFixturesMap(map[string]axiom.Fixture{
+			"a": func(cfg *axiom.Config) (any, func(), error) { return axiom.GetFixture[any](cfg, "b"), nil, nil },
+			"b": func(cfg *axiom.Config) (any, func(), error) { return axiom.GetFixture[any](cfg, "a"), nil, nil },
+		})),
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) { events = append(events, e) })),
+	}
+	cfg.Fixtures.Normalize()
+
+	runFixtureFatal(func() { _ = axiom.GetFixture[any](cfg, "a") })
+
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{"fixture dependency cycle: a -> b -> a"}, cfg.Failures())
+	last := events[len(events)-1]
+	assert.Equal(t, axiom.EventTypeFixtureSetupFailed, last.Type)
+	assert.Equal(t, "dependency cycle", last.Message)
+}
+
+func TestConfig_Test_ReportsInvalidFixtureGraph(t *testing.T) {
+	fakeT := &testing.T{}
+	called := false
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("user", func(cfg *axiom.Config) (any, func(), error) { return nil, nil, nil }),
+		axiom.WithRunnerFixtureDeps("user", "db"),
+	)
+	c := axiom.NewCase()
+	cfg := r.BuildConfig(fakeT, &c)
+	cfg.SubT = fakeT
+
+	runFixtureFatal(func() {
+		cfg.Test(func(cfg *axiom.Config) { called = true })
+	})
+
+	assert.False(t, called)
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{`invalid fixtures: fixture "user" depends on unknown fixture "db"`}, cfg.Failures())
+}
+
+func TestFixtures_ScopeDefaultsToAttempt(t *testing.T) {
+	f := axiom.NewFixtures(axiom.WithFixtureScope("db", axiom.FixtureScopeRunner))
+
+	assert.Equal(t, axiom.FixtureScopeRunner, f.Scope("db"))
+	assert.Equal(t, axiom.FixtureScopeAttempt, f.Scope("user"))
+}
+
+type scopedFixtureSuite struct {
+	axiom.Suite
+	values []int
+}
+
+func (s *scopedFixtureSuite) TestDB() {
+	s.RunCase(axiom.NewCase(), func(cfg *axiom.Config) {
+		s.values = append(s.values, axiom.GetFixture[int](cfg, "db"))
+	})
+}
+
+func TestGetFixture_SuiteScope_SharedAcrossSuiteTests(t *testing.T) {
+	var setups, cleanups int
+	suite := &scopedFixtureSuite{}
+
+	t.Run("suite", func(t *testing.T) {
+		runSuite(t, suite, func(s *axiom.SuiteRunner[*scopedFixtureSuite]) {
+			s.Test("TestFirst", (*scopedFixtureSuite).TestDB)
+			s.Test("TestSecond", (*scopedFixtureSuite).TestDB)
+		}, axiom.WithSuiteConfigRunner(axiom.NewRunner(
+			axiom.WithRunnerFixture("db", func(cfg *axiom.Config) (any, func(), error) {
+				setups++
+				return setups, func() { cleanups++ }, nil
+			}),
+			axiom.WithRunnerFixtureScope("db", axiom.FixtureScopeSuite),
+		)))
+
+		assert.Equal(t, 0, cleanups)
+	})
+
+	assert.Equal(t, []int{1, 1}, suite.values)
+	assert.Equal(t, 1, setups)
+	assert.Equal(t, 1, cleanups)
+}
+
+func TestGetFixture_SuiteScope_DefaultsToTest(t *testing.T) {
+	var setups, cleanups int
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("db", func(cfg *axiom.Config) (any, func(), error) {
+			setups++
+			return setups, func() { cleanups++ }, nil
+		}),
+		axiom.WithRunnerFixtureScope("db", axiom.FixtureScopeSuite),
+	)
+
+	for range 2 {
+		t.Run("test", func(t *testing.T) {
+			for range 2 {
+				r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+					axiom.GetFixture[int](cfg, "db")
+				})
+			}
+		})
+	}
+
+	assert.Equal(t, 2, setups)
+	assert.Equal(t, 2, cleanups)
+}
+
+func TestGetFixture_SuiteScope_OutlivesContextOfFirstCase(t *testing.T) {
+	var factoryT *testing.T
+	var cleanupErr error
+	r := axiom.NewRunner(
+		axiom.WithRunnerTimeout(axiom.WithTimeoutCase(time.Minute)),
+		axiom.WithRunnerFixture("db", func(cfg *axiom.Config) (any, func(), error) {
+			factoryT = cfg.T()
+			ctx := cfg.Context.DB
+			return ctx, func() { cleanupErr = ctx.Err() }, nil
+		}),
+		axiom.WithRunnerFixtureScope("db", axiom.FixtureScopeSuite),
+	)
+
+	var first context.Context
+	t.Run("suite", func(t *testing.T) {
+		r.RunCase(t, axiom.NewCase(axiom.WithCaseName("first")), func(cfg *axiom.Config) {
+			first = cfg.Context.Raw
+			axiom.GetFixture[context.Context](cfg, "db")
+		})
+		require.Error(t, first.Err(), "the case timeout context ends with the case")
+
+		r.RunCase(t, axiom.NewCase(axiom.WithCaseName("second")), func(cfg *axiom.Config) {
+			assert.NoError(t, axiom.GetFixture[context.Context](cfg, "db").Err())
+		})
+		assert.Same(t, t, factoryT)
+	})
+
+	assert.NoError(t, cleanupErr)
+}
+
+func TestGetFixture_RunnerScope_TornDownAfterPackage(t *testing.T) {
+	var order []string
+	r := axiom.NewRunner(
+		axiom.WithRunnerHooks(
+			axiom.WithAfterAll(func(_ *axiom.Runner) { order = append(order, "after-all") }),
+		),
+		axiom.WithRunnerFixture("db", func(cfg *axiom.Config) (any, func(), error) {
+			order = append(order, "setup")
+			return "db", func() { order = append(order, "cleanup") }, nil
+		}),
+		axiom.WithRunnerFixtureScope("db", axiom.FixtureScopeRunner),
+	)
+
+	code := axiom.RunPackageWith(r, func() int {
+		for range 2 {
+			t.Run("case", func(t *testing.T) {
+				r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+					assert.Equal(t, "db", axiom.GetFixture[string](cfg, "db"))
+				})
+			})
+		}
+		order = append(order, "entry-end")
+		return 0
+	})
+
+	assert.Equal(t, 0, code)
+	assert.Equal(t, []string{"setup", "entry-end", "after-all", "cleanup"}, order)
+}
+
+func TestGetFixture_ConcurrentCallsSetUpOnce(t *testing.T) {
+	var setups, cleanups, starts atomic.Int64
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("db", func(cfg *axiom.Config) (any, func(), error) {
+			setups.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return "db", func() { cleanups.Add(1) }, nil
+		}),
+		axiom.WithRunnerFixture("user", func(cfg *axiom.Config) (any, func(), error) {
+			return axiom.GetFixture[string](cfg, "db") + "/user", nil, nil
+		}),
+		axiom.WithRunnerFixtureDeps("user", "db"),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeFixtureSetupStart && e.Name == "db" {
+				starts.Add(1)
+			}
+		})),
+	)
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		var wg sync.WaitGroup
+		for i := range 16 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if i%2 == 0 {
+					assert.Equal(t, "db", axiom.GetFixture[string](cfg, "db"))
+					return
+				}
+				assert.Equal(t, "db/user", axiom.GetFixture[string](cfg, "user"))
+			}()
+		}
+		wg.Wait()
+	})
+
+	assert.Equal(t, int64(1), setups.Load())
+	assert.Equal(t, int64(1), starts.Load())
+	assert.Equal(t, int64(1), cleanups.Load())
+}
+
+func TestGetFixture_DetectsCycleAcrossGoroutines(t *testing.T) {
+	var started sync.WaitGroup
+	started.Add(2)
+	fixture := func(dep string) axiom.Fixture {
+		return func(cfg *axiom.Config) (any, func(), error) {
+			started.Done()
+			started.Wait()
+			return axiom.GetFixture[any](cfg, dep), nil, nil
+		}
+	}
+
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Fixtures: axiom.NewFixtures(axiom.WithFixturesMap(map[string]axiom.Fixture{
+			"a": fixture("b"),
+			"b": fixture("a"),
+		})),
+	}
+	cfg.Fixtures.Normalize()
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = axiom.GetFixture[any](cfg, name)
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, fakeT.Failed())
+	assert.Contains(t, []string{
+		"fixture dependency cycle: a -> b -> a",
+		"fixture dependency cycle: b -> a -> b",
+	}, cfg.Failures()[0])
+}
+
+func TestGetFixture_DetectsCycleThroughSetupOfAnotherGoroutine(t *testing.T) {
+	var started sync.WaitGroup
+	started.Add(2)
+	fixture := func(dep string, wait bool) axiom.Fixture {
+		return func(cfg *axiom.Config) (any, func(), error) {
+			if wait {
+				started.Done()
+				started.Wait()
+			}
+			return axiom.GetFixture[any](cfg, dep), nil, nil
+		}
+	}
+
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Fixtures: axiom.NewFixtures(axiom.WithFixturesMap(map[string]axiom.Fixture{
+			"a": fixture("x", false),
+			"x": fixture("b", true),
+			"b": fixture("a", true),
+		})),
+	}
+	cfg.Fixtures.Normalize()
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = axiom.GetFixture[any](cfg, name)
+		}()
+	}
+	wg.Wait()
+
+	assert.True(t, fakeT.Failed())
+	assert.Contains(t, []string{
+		"fixture dependency cycle: a -> x -> b -> a",
+		"fixture dependency cycle: b -> a -> x -> b",
+		"fixture dependency cycle: x -> b -> a -> x",
+	}, cfg.Failures()[0])
+}
+
+func TestGetFixture_FactoryConfigSharesAttemptState(t *testing.T) {
+	key := axiom.NewLocalKey[string]("from fixture")
+	cfg := &axiom.Config{
+		SubT: &testing.T{},
+		Fixtures: axiom.NewFixtures(axiom.WithFixture("user", func(cfg *axiom.Config) (any, func(), error) {
+			axiom.SetLocal(cfg, key, "set")
+			cfg.Errorf("reported by fixture")
+			return "user", nil, nil
+		})),
+	}
+	cfg.Fixtures.Normalize()
+
+	assert.Equal(t, "user", axiom.GetFixture[string](cfg, "user"))
+
+	assert.Equal(t, "set", axiom.MustLocal(cfg, key))
+	assert.Equal(t, []string{"reported by fixture"}, cfg.Failures())
+	assert.Contains(t, cfg.Fixtures.Cache, "user")
+}
@@ -1,8 +1,12 @@
 package axiom
 
-import "fmt"
+import (
+	"fmt"
+	"sync"
+)
 
 type Local struct {
+	mu     sync.RWMutex
 	values map[any]any
 }
 
@@ -26,10 +30,14 @@ func SetLocal[T any](cfg *Config, key LocalKey[T], value T) {
 		panic("local: key must be created with NewLocalKey")
 	}
 
-	if cfg.Local.values == nil {
-		cfg.Local.values = map[any]any{}
+	local := &cfg.root().Local
+	local.mu.Lock()
+	defer local.mu.Unlock()
+
+	if local.values == nil {
+		local.values = map[any]any{}
 	}
-	cfg.Local.values[key] = value
+	local.values[key] = value
 }
 
 func GetLocal[T any](cfg *Config, key LocalKey[T]) (T, bool) {
@@ -40,7 +48,10 @@ func GetLocal[T any](cfg *Config, key LocalKey[T]) (T, bool) {
 		panic("local: key must be created with NewLocalKey")
 	}
 
-	v, ok := cfg.Local.values[key]
+	local := &cfg.root().Local
+	local.mu.RLock()
+	v, ok := local.values[key]
+	local.mu.RUnlock()
 	if !ok {
 		var zero T
 		return zero, false
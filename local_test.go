@@ -1,6 +1,8 @@
 package axiom_test
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -142,3 +144,25 @@ func TestLocalKey_PanicsWhenNameIsEmpty(t *testing.T) {
 		_ = axiom.MustLocal(cfg, key)
 	})
 }
+
+func TestLocal_ConcurrentSetAndGet(t *testing.T) {
+	cfg := &axiom.Config{}
+
+	var wg sync.WaitGroup
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			key := axiom.NewLocalKey[int](fmt.Sprintf("key-%d", i%4))
+			axiom.SetLocal(cfg, key, i)
+			_, ok := axiom.GetLocal(cfg, key)
+			assert.True(t, ok)
+		}()
+	}
+	wg.Wait()
+
+	for i := range 4 {
+		_, ok := axiom.GetLocal(cfg, axiom.NewLocalKey[int](fmt.Sprintf("key-%d", i)))
+		assert.True(t, ok)
+	}
+}
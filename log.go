@@ -14,9 +14,26 @@ func (l LogLevel) String() string {
 	return string(l)
 }
 
+const (
+	LogAttrCaseID   = "case_id"
+	LogAttrCaseName = "case_name"
+	LogAttrAttempt  = "attempt"
+	LogAttrStep     = "step"
+)
+
+type Attr struct {
+	Key   string `json:"key"`
+	Value any    `json:"value"`
+}
+
+func NewAttr(key string, value any) Attr {
+	return Attr{Key: key, Value: value}
+}
+
 type Log struct {
 	Text  string
 	Level LogLevel
+	Attrs []Attr
 }
 
 type LogOption func(*Log)
@@ -37,6 +54,14 @@ func WithLogLevel(level LogLevel) LogOption {
 	return func(l *Log) { l.Level = level }
 }
 
+func WithLogAttr(key string, value any) LogOption {
+	return func(l *Log) { l.Attrs = append(l.Attrs, NewAttr(key, value)) }
+}
+
+func WithLogAttrs(attrs ...Attr) LogOption {
+	return func(l *Log) { l.Attrs = append(l.Attrs, attrs...) }
+}
+
 func NewDebugLog(text string) Log {
 	return NewLog(
 		WithLogLevel(LogLevelDebug),
@@ -71,3 +96,20 @@ func NewFatalLog(text string) Log {
 		WithLogText(text),
 	)
 }
+
+func (l Log) Attr(key string) (any, bool) {
+	for _, attr := range l.Attrs {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+func (l Log) With(attrs ...Attr) Log {
+	result := l
+	result.Attrs = append(append([]Attr{}, l.Attrs...), attrs...)
+
+	return result
+}
@@ -78,3 +78,30 @@ func TestNewFatalLog(t *testing.T) {
 	assert.Equal(t, axiom.LogLevelFatal, l.Level)
 	assert.Equal(t, "fatal msg", l.Text)
 }
+
+func TestWithLogAttr(t *testing.T) {
+	l := axiom.NewLog(
+		axiom.WithLogAttr("user_id", 42),
+		axiom.WithLogAttrs(axiom.NewAttr("region", "eu")),
+	)
+
+	assert.Equal(t, []axiom.Attr{{Key: "user_id", Value: 42}, {Key: "region", Value: "eu"}}, l.Attrs)
+
+	value, ok := l.Attr("region")
+	assert.True(t, ok)
+	assert.Equal(t, "eu", value)
+
+	_, ok = l.Attr("missing")
+	assert.False(t, ok)
+}
+
+func TestLog_With_DoesNotShareAttrs(t *testing.T) {
+	base := axiom.NewLog(axiom.WithLogAttr("a", 1))
+
+	first := base.With(axiom.NewAttr("b", 2))
+	second := base.With(axiom.NewAttr("c", 3))
+
+	assert.Len(t, base.Attrs, 1)
+	assert.Equal(t, []axiom.Attr{{Key: "a", Value: 1}, {Key: "b", Value: 2}}, first.Attrs)
+	assert.Equal(t, []axiom.Attr{{Key: "a", Value: 1}, {Key: "c", Value: 3}}, second.Attrs)
+}
@@ -1,6 +1,10 @@
 package axiom
 
-import "testing"
+import (
+	"fmt"
+	"os"
+	"testing"
+)
 
 func RunPackage(m *testing.M, r *Runner) int {
 	if m == nil {
@@ -22,5 +26,10 @@ func RunPackageWith(r *Runner, entry func() int) int {
 
 	r.ApplyStart()
 	defer r.ApplyFinish()
+	if err := r.WarmUpErr(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
 	return entry()
 }
@@ -1,8 +1,15 @@
 package axiom
 
+import "slices"
+
 type Parallel struct {
 	Enabled    bool
 	EnabledSet bool
+
+	MaxConcurrency int
+	Groups         []string
+	GroupLimits    map[string]int
+	Exclusive      bool
 }
 
 type ParallelOption func(*Parallel)
@@ -30,8 +37,57 @@ func WithParallelDisabled() ParallelOption {
 	}
 }
 
+// WithParallelMaxConcurrency makes a case start only while fewer than max
+// cases of the runner are running.
+func WithParallelMaxConcurrency(max int) ParallelOption {
+	return func(p *Parallel) { p.MaxConcurrency = max }
+}
+
+// WithParallelGroup adds a case to concurrency groups, typically named after
+// the shared backend the case uses.
+func WithParallelGroup(names ...string) ParallelOption {
+	return func(p *Parallel) {
+		for _, name := range names {
+			if !slices.Contains(p.Groups, name) {
+				p.Groups = append(p.Groups, name)
+			}
+		}
+	}
+}
+
+// WithParallelGroupLimit makes cases of group start only while fewer than
+// limit cases of the group are running.
+func WithParallelGroupLimit(group string, limit int) ParallelOption {
+	return func(p *Parallel) {
+		if p.GroupLimits == nil {
+			p.GroupLimits = map[string]int{}
+		}
+		p.GroupLimits[group] = limit
+	}
+}
+
+// WithParallelExclusive makes a case wait until no other case of the runner
+// is running and keeps every other case from starting until it finished.
+func WithParallelExclusive() ParallelOption {
+	return func(p *Parallel) { p.Exclusive = true }
+}
+
 func (p *Parallel) Copy() Parallel {
-	return Parallel{Enabled: p.Enabled, EnabledSet: p.EnabledSet}
+	result := Parallel{
+		Enabled:        p.Enabled,
+		EnabledSet:     p.EnabledSet,
+		MaxConcurrency: p.MaxConcurrency,
+		Groups:         slices.Clone(p.Groups),
+		Exclusive:      p.Exclusive,
+	}
+	if p.GroupLimits != nil {
+		result.GroupLimits = make(map[string]int, len(p.GroupLimits))
+		for group, limit := range p.GroupLimits {
+			result.GroupLimits[group] = limit
+		}
+	}
+
+	return result
 }
 
 func (p *Parallel) Join(other Parallel) Parallel {
@@ -41,6 +97,30 @@ func (p *Parallel) Join(other Parallel) Parallel {
 		result.Enabled = other.Enabled
 		result.EnabledSet = true
 	}
+	if other.MaxConcurrency != 0 {
+		result.MaxConcurrency = other.MaxConcurrency
+	}
+	if other.Exclusive {
+		result.Exclusive = true
+	}
+	WithParallelGroup(other.Groups...)(&result)
+	for group, limit := range other.GroupLimits {
+		WithParallelGroupLimit(group, limit)(&result)
+	}
 
 	return result
 }
+
+// Limited reports whether the runner may hold a case back before it starts.
+func (p *Parallel) Limited() bool {
+	if p.Exclusive || p.MaxConcurrency > 0 {
+		return true
+	}
+	for _, group := range p.Groups {
+		if p.GroupLimits[group] > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package axiom
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// ParallelStepsError reports every branch of ParallelSteps that failed.
+type ParallelStepsError struct {
+	Total    int
+	Failures []ParallelStepFailure
+}
+
+type ParallelStepFailure struct {
+	Name string
+	Err  error
+}
+
+func (e *ParallelStepsError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "parallel steps failed for %d of %d step(s):", len(e.Failures), e.Total)
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&b, "\n  - %s: %v", failure.Name, failure.Err)
+	}
+
+	return b.String()
+}
+
+func (e *ParallelStepsError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+
+	return errs
+}
+
+// ParallelSteps runs every function of steps concurrently as a step named by
+// its key and waits for all of them. Each function receives the Config of its
+// branch: steps started through it nest under the branch and get their own
+// step timeout, and its Fatalf ends only the branch. Once all branches
+// finished, failures and panics of every branch are reported together as a
+// ParallelStepsError and stop the test.
+func (c *Config) ParallelSteps(steps map[string]func(cfg *Config)) {
+	names := slices.Sorted(maps.Keys(steps))
+	for _, name := range names {
+		if steps[name] == nil {
+			panic(fmt.Sprintf("step: nil function for parallel step %q", name))
+		}
+	}
+
+	branches := make([]*Config, len(names))
+	completed := make([]bool, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		branches[i] = c.newBranch()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			branches[i].Step(name, func() { steps[name](branches[i]) })
+			completed[i] = true
+		}()
+	}
+	wg.Wait()
+
+	report := &ParallelStepsError{Total: len(names)}
+	for i, name := range names {
+		var err error
+		switch failures := branches[i].Failures(); {
+		case len(failures) > 0:
+			err = errors.New(strings.Join(failures, "\n"))
+		case !completed[i]:
+			err = errors.New("stopped before completing")
+		default:
+			continue
+		}
+		report.Failures = append(report.Failures, ParallelStepFailure{Name: name, Err: err})
+	}
+
+	if len(report.Failures) > 0 {
+		c.Fatalf("%v", report)
+	}
+}
+
+// newBranch derives the Config of a ParallelSteps branch. It starts at the
+// innermost frame and soft scope of c and keeps its own from there on.
+func (c *Config) newBranch() *Config {
+	branch := c.derive()
+	branch.branch = true
+
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	branch.frames = slices.Clone(spans.frames)
+	branch.softScopes = slices.Clone(spans.softScopes)
+
+	return branch
+}
@@ -0,0 +1,172 @@
+package axiom_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_ParallelSteps_RunsBranchesConcurrentlyAsNestedSteps(t *testing.T) {
+	var mu sync.Mutex
+	var events []axiom.Event
+	cfg := &axiom.Config{
+		SubT: t,
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, e)
+		})),
+	}
+
+	names := []string{"user 1", "user 2", "user 3"}
+	var started sync.WaitGroup
+	started.Add(len(names))
+
+	steps := map[string]func(cfg *axiom.Config){}
+	for _, name := range names {
+		steps[name] = func(cfg *axiom.Config) {
+			started.Done()
+			started.Wait()
+
+			cfg.Step("insert "+name, func() {})
+		}
+	}
+
+	cfg.Step("create users", func() {
+		cfg.ParallelSteps(steps)
+	})
+
+	assert.Empty(t, cfg.Failures())
+
+	group := findEvent(t, events, axiom.EventTypeStepStart, "create users")
+	for _, name := range names {
+		branch := findEvent(t, events, axiom.EventTypeStepStart, name)
+		assert.Equal(t, group.ID, branch.ParentID, name)
+		assert.Equal(t, []string{"create users", name}, branch.Steps)
+
+		insert := findEvent(t, events, axiom.EventTypeStepStart, "insert "+name)
+		assert.Equal(t, branch.ID, insert.ParentID, name)
+		assert.Equal(t, []string{"create users", name, "insert " + name}, insert.Steps)
+	}
+
+	finish := findEvent(t, events, axiom.EventTypeStepFinish, "create users")
+	assert.Equal(t, group.ID, finish.ID)
+}
+
+func TestConfig_ParallelSteps_AggregatesBranchFailures(t *testing.T) {
+	cfg := &axiom.Config{SubT: &testing.T{}}
+
+	stopped := true
+	continued := false
+	runFixtureFatal(func() {
+		cfg.ParallelSteps(map[string]func(cfg *axiom.Config){
+			"a": func(*axiom.Config) { panic("boom") },
+			"b": func(cfg *axiom.Config) { cfg.Errorf("bad") },
+			"c": func(*axiom.Config) {},
+			"d": func(cfg *axiom.Config) {
+				cfg.Fatalf("stop")
+				stopped = false
+			},
+		})
+		continued = true
+	})
+
+	assert.True(t, stopped)
+	assert.False(t, continued)
+	assert.True(t, cfg.SubT.Failed())
+
+	failures := cfg.Failures()
+	require.NotEmpty(t, failures)
+	assert.Equal(t,
+		"parallel steps failed for 3 of 4 step(s):\n"+
+			"  - a: panic in step \"a\": boom\n"+
+			"  - b: bad\n"+
+			"  - d: stop",
+		failures[len(failures)-1],
+	)
+}
+
+func TestConfig_ParallelSteps_KeepsSoftScopesPerBranch(t *testing.T) {
+	cfg := &axiom.Config{SubT: &testing.T{}}
+
+	runFixtureFatal(func() {
+		cfg.ParallelSteps(map[string]func(cfg *axiom.Config){
+			"a": func(cfg *axiom.Config) {
+				cfg.SoftStep("check a", func() {
+					cfg.AssertFailed(axiom.Assert{Type: axiom.AssertEqual, Message: "a"}, "a failed")
+				})
+			},
+			"b": func(cfg *axiom.Config) {
+				cfg.SoftStep("check b", func() {})
+			},
+		})
+	})
+
+	failures := cfg.Failures()
+	require.Len(t, failures, 2)
+	assert.Contains(t, failures[0], `1 soft assertion(s) failed in step "check a"`)
+	assert.Contains(t, failures[1], "parallel steps failed for 1 of 2 step(s):\n  - a: ")
+}
+
+func TestConfig_ParallelSteps_NestsStepsOfGoroutinesStartedInBranch(t *testing.T) {
+	var mu sync.Mutex
+	var events []axiom.Event
+	cfg := &axiom.Config{
+		SubT: t,
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, e)
+		})),
+	}
+
+	cfg.ParallelSteps(map[string]func(cfg *axiom.Config){
+		"a": func(cfg *axiom.Config) {
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				cfg.Step("background", func() {})
+			}()
+			<-done
+		},
+	})
+
+	branch := findEvent(t, events, axiom.EventTypeStepStart, "a")
+	background := findEvent(t, events, axiom.EventTypeStepStart, "background")
+	assert.Equal(t, branch.ID, background.ParentID)
+	assert.Equal(t, []string{"a", "background"}, background.Steps)
+}
+
+func TestConfig_ParallelSteps_AppliesStepTimeoutInsideBranches(t *testing.T) {
+	cfg := &axiom.Config{
+		SubT:    &testing.T{},
+		Timeout: axiom.NewTimeout(axiom.WithTimeoutStep(20 * time.Millisecond)),
+	}
+	cfg.Context.Normalize()
+
+	var fastErr error
+	runFixtureFatal(func() {
+		cfg.ParallelSteps(map[string]func(cfg *axiom.Config){
+			"slow": func(cfg *axiom.Config) { <-cfg.Context.Raw.Done() },
+			"fast": func(cfg *axiom.Config) { fastErr = cfg.Context.Raw.Err() },
+		})
+	})
+
+	assert.NoError(t, fastErr)
+	assert.Equal(t, []string{
+		`step "slow" timed out after 20ms`,
+		"parallel steps failed for 1 of 2 step(s):\n  - slow: step \"slow\" timed out after 20ms",
+	}, cfg.Failures())
+}
+
+func TestConfig_ParallelSteps_NilFunctionPanics(t *testing.T) {
+	cfg := &axiom.Config{SubT: t}
+
+	assert.PanicsWithValue(t, `step: nil function for parallel step "b"`, func() {
+		cfg.ParallelSteps(map[string]func(cfg *axiom.Config){"a": func(*axiom.Config) {}, "b": nil})
+	})
+}
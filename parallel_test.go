@@ -123,3 +123,71 @@ func TestParallelCopy_PreservesEnabledSet(t *testing.T) {
 	assert.False(t, cp.Enabled)
 	assert.True(t, cp.EnabledSet)
 }
+
+func TestNewParallel_WithLimits(t *testing.T) {
+	p := axiom.NewParallel(
+		axiom.WithParallelMaxConcurrency(4),
+		axiom.WithParallelGroup("payments-db", "kafka"),
+		axiom.WithParallelGroup("payments-db"),
+		axiom.WithParallelGroupLimit("payments-db", 2),
+	)
+
+	assert.Equal(t, 4, p.MaxConcurrency)
+	assert.Equal(t, []string{"payments-db", "kafka"}, p.Groups)
+	assert.Equal(t, map[string]int{"payments-db": 2}, p.GroupLimits)
+}
+
+func TestParallelJoin_MergesLimits(t *testing.T) {
+	base := axiom.NewParallel(
+		axiom.WithParallelMaxConcurrency(8),
+		axiom.WithParallelGroupLimit("payments-db", 2),
+		axiom.WithParallelGroupLimit("kafka", 4),
+	)
+	other := axiom.NewParallel(
+		axiom.WithParallelMaxConcurrency(2),
+		axiom.WithParallelGroup("payments-db"),
+		axiom.WithParallelGroupLimit("kafka", 1),
+	)
+
+	result := base.Join(other)
+
+	assert.Equal(t, 2, result.MaxConcurrency)
+	assert.Equal(t, []string{"payments-db"}, result.Groups)
+	assert.Equal(t, map[string]int{"payments-db": 2, "kafka": 1}, result.GroupLimits)
+	assert.Equal(t, map[string]int{"payments-db": 2, "kafka": 4}, base.GroupLimits)
+}
+
+func TestParallelJoin_KeepsMaxConcurrencyWhenOtherNotSet(t *testing.T) {
+	base := axiom.NewParallel(axiom.WithParallelMaxConcurrency(3))
+
+	result := base.Join(axiom.NewParallel(axiom.WithParallelEnabled()))
+
+	assert.Equal(t, 3, result.MaxConcurrency)
+}
+
+func TestParallel_Limited(t *testing.T) {
+	for name, test := range map[string]struct {
+		parallel axiom.Parallel
+		limited  bool
+	}{
+		"enabled only":        {axiom.NewParallel(axiom.WithParallelEnabled()), false},
+		"group without limit": {axiom.NewParallel(axiom.WithParallelGroup("payments-db")), false},
+		"limit without group": {axiom.NewParallel(axiom.WithParallelGroupLimit("payments-db", 1)), false},
+		"max concurrency":     {axiom.NewParallel(axiom.WithParallelMaxConcurrency(1)), true},
+		"limited group": {axiom.NewParallel(
+			axiom.WithParallelGroup("payments-db"),
+			axiom.WithParallelGroupLimit("payments-db", 1),
+		), true},
+	} {
+		assert.Equal(t, test.limited, test.parallel.Limited(), name)
+	}
+}
+
+func TestParallelJoin_KeepsExclusive(t *testing.T) {
+	base := axiom.NewParallel(axiom.WithParallelExclusive())
+
+	result := base.Join(axiom.NewParallel(axiom.WithParallelEnabled()))
+
+	assert.True(t, result.Exclusive)
+	assert.True(t, result.Limited())
+}
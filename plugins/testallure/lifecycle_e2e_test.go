@@ -2,6 +2,7 @@ package testallure_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
@@ -11,6 +12,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/Nikita-Filonov/axiom/plugins/testallure"
@@ -106,6 +108,31 @@ func TestPlugin_PreExecutionCaseSkipDoesNotEnterAllureLifecycle(t *testing.T) {
 	assert.Empty(t, results)
 }
 
+func TestPlugin_PollStepsCarryAttemptCount(t *testing.T) {
+	results, resultsDir := runLifecycleProbeInDir(t, "poll", true)
+	require.Len(t, results, 2)
+
+	passed := resultWithStep(t, results, "eventually: queue drained")
+	assertResultStep(t, passed, "eventually: queue drained", model.StatusPassed)
+	require.Len(t, passed.Steps[0].Attachments, 1)
+	assert.Equal(t, "eventually: queue drained attempts", passed.Steps[0].Attachments[0].Name)
+	assert.Equal(
+		t,
+		`eventually "queue drained" satisfied after 2 attempt(s)`,
+		string(readAllureAttachment(t, resultsDir, passed.Steps[0].Attachments[0])),
+	)
+
+	failed := resultWithStep(t, results, "consistently: balance stable")
+	assertResultStep(t, failed, "consistently: balance stable", model.StatusFailed)
+	require.Len(t, failed.Steps[0].Attachments, 1)
+	assert.Equal(t, "consistently: balance stable last failure", failed.Steps[0].Attachments[0].Name)
+	assert.Equal(
+		t,
+		`consistently "balance stable" failed after 1 attempt(s): balance changed`,
+		string(readAllureAttachment(t, resultsDir, failed.Steps[0].Attachments[0])),
+	)
+}
+
 func TestPlugin_LifecycleProbe(t *testing.T) {
 	switch os.Getenv(lifecycleProbeEnv) {
 	case "":
@@ -122,6 +149,8 @@ func TestPlugin_LifecycleProbe(t *testing.T) {
 		runRuntimeSkipProbe(t)
 	case "case-skip":
 		runCaseSkipProbe(t)
+	case "poll":
+		runPollProbe(t)
 	default:
 		t.Fatalf("unknown lifecycle probe %q", os.Getenv(lifecycleProbeEnv))
 	}
@@ -267,9 +296,39 @@ func runCaseSkipProbe(t *testing.T) {
 	})
 }
 
+func runPollProbe(t *testing.T) {
+	runner := axiom.NewRunner(
+		axiom.WithRunnerPlugins(testallure.Plugin()),
+	)
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("queue is drained")), func(cfg *axiom.Config) {
+		var polls int
+		cfg.Eventually("queue drained", time.Second, time.Millisecond, func() error {
+			polls++
+			if polls < 2 {
+				return fmt.Errorf("%d messages left", 2-polls)
+			}
+			return nil
+		})
+	})
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("balance is stable")), func(cfg *axiom.Config) {
+		cfg.Consistently("balance stable", time.Second, time.Millisecond, func() error {
+			return errors.New("balance changed")
+		})
+	})
+}
+
 func runLifecycleProbe(t *testing.T, mode string, wantFailure bool) []model.TestResult {
 	t.Helper()
 
+	results, _ := runLifecycleProbeInDir(t, mode, wantFailure)
+	return results
+}
+
+func runLifecycleProbeInDir(t *testing.T, mode string, wantFailure bool) ([]model.TestResult, string) {
+	t.Helper()
+
 	resultsDir := t.TempDir()
 	command := exec.Command(
 		os.Args[0],
@@ -286,7 +345,7 @@ func runLifecycleProbe(t *testing.T, mode string, wantFailure bool) []model.Test
 		require.NoError(t, err, "probe output:\n%s", output)
 	}
 
-	return readAllureResults(t, resultsDir)
+	return readAllureResults(t, resultsDir), resultsDir
 }
 
 func lifecycleProbeEnvironment(mode, resultsDir string) []string {
@@ -1,68 +1,89 @@
 package testallure
 
 import (
-	"sync/atomic"
+	"sync"
 
 	"github.com/Nikita-Filonov/axiom"
 	allure "github.com/allure-framework/allure-go/commons/gotest"
 )
 
+// allureContextState keeps the allure context of every active axiom span, so
+// steps running concurrently in ParallelSteps branches attach to their own
+// parent step.
 type allureContextState struct {
-	current atomic.Pointer[allure.Context]
+	mu       sync.Mutex
+	contexts map[string]*allure.Context
+}
+
+func (s *allureContextState) get(span string) *allure.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.contexts[span]
+}
+
+func (s *allureContextState) swap(span string, ctx *allure.Context) *allure.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := s.contexts[span]
+	if ctx == nil {
+		delete(s.contexts, span)
+	} else {
+		s.contexts[span] = ctx
+	}
+
+	return previous
 }
 
 func Plugin(options ...allure.Option) axiom.Plugin {
 	baseOptions := append([]allure.Option(nil), options...)
 
 	return func(cfg *axiom.Config) {
-		state := &allureContextState{}
+		state := &allureContextState{contexts: map[string]*allure.Context{}}
 
 		cfg.Runtime.EmitTestWrap(func(next axiom.TestAction) axiom.TestAction {
 			return func(c *axiom.Config) {
 				testOptions := append(BuildAllureOptions(c), baseOptions...)
 				allure.Wrap(c.SubT, func(ctx *allure.Context) {
-					previous := state.current.Swap(ctx)
-					defer state.current.Store(previous)
+					span, _ := c.Span()
+					previous := state.swap(span, ctx)
+					defer state.swap(span, previous)
 
 					next(c)
 				}, testOptions...)
 			}
 		})
 
-		cfg.Runtime.EmitStepWrap(func(name string, next axiom.StepAction) axiom.StepAction {
-			return func() {
-				runAllureStep(state, name, next)
-			}
-		})
-
-		cfg.Runtime.EmitSetupWrap(func(name string, next axiom.SetupAction) axiom.SetupAction {
-			return func() {
-				runAllureStep(state, name, next)
-			}
-		})
-
-		cfg.Runtime.EmitTeardownWrap(func(name string, next axiom.TeardownAction) axiom.TeardownAction {
+		cfg.Runtime.EmitSpanWrap(func(c *axiom.Config, name string, next func()) func() {
 			return func() {
-				runAllureStep(state, name, next)
+				runAllureStep(state, c, name, next)
 			}
 		})
 
+		// Artefact sinks do not receive the Config that attached the artefact,
+		// so artefacts of a ParallelSteps branch attach to the step that
+		// started ParallelSteps.
 		cfg.Runtime.EmitArtefactSink(func(a axiom.Artefact) {
-			handleArtefact(state.current.Load(), cfg, a)
+			span, _ := cfg.Span()
+			handleArtefact(state.get(span), cfg, a)
 		})
 	}
 }
 
-func runAllureStep(state *allureContextState, name string, next func()) {
-	ctx := state.current.Load()
+// runAllureStep nests the step, setup or teardown under the context of its
+// parent span.
+func runAllureStep(state *allureContextState, cfg *axiom.Config, name string, next func()) {
+	span, parent := cfg.Span()
+	ctx := state.get(parent)
 	if ctx == nil {
 		next()
 		return
 	}
 
 	ctx.Step(name, func(stepContext *allure.Context) {
-		previous := state.current.Swap(stepContext)
-		defer state.current.Store(previous)
+		previous := state.swap(span, stepContext)
+		defer state.swap(span, previous)
 
 		next()
 	})
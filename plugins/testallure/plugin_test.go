@@ -19,9 +19,7 @@ func TestPlugin_AddsAllExpectedRuntimeHooks(t *testing.T) {
 	testallure.Plugin()(cfg)
 
 	assert.Len(t, cfg.Runtime.TestWraps, 1)
-	assert.Len(t, cfg.Runtime.StepWraps, 1)
-	assert.Len(t, cfg.Runtime.SetupWraps, 1)
-	assert.Len(t, cfg.Runtime.TeardownWraps, 1)
+	assert.Len(t, cfg.Runtime.SpanWraps, 1)
 	assert.Len(t, cfg.Runtime.ArtefactSinks, 1)
 }
 
@@ -112,7 +110,7 @@ func TestPlugin_ParallelCasesKeepContextsIsolated(t *testing.T) {
 				started.Wait()
 
 				cfg.Step(name+" step", func() {
-					cfg.Runtime.Artefact(axiom.Artefact{
+					cfg.Artefact(axiom.Artefact{
 						Name: name + ".txt",
 						Type: axiom.ArtefactTypeText,
 						Data: []byte(name),
@@ -142,3 +140,51 @@ func TestPlugin_ParallelCasesKeepContextsIsolated(t *testing.T) {
 		assert.Equal(t, name, string(snapshot.Attachments[attachment.Source]))
 	}
 }
+
+func TestPlugin_ParallelStepsNestUnderTheirBranch(t *testing.T) {
+	memoryWriter := writer.NewInMemoryWriter()
+	cfg := &axiom.Config{
+		SubT: t,
+		Case: &axiom.Case{Name: "create users"},
+	}
+	testallure.Plugin(allure.WithWriter(memoryWriter))(cfg)
+
+	names := []string{"user 1", "user 2"}
+	var started sync.WaitGroup
+	started.Add(len(names))
+
+	cfg.Test(func(cfg *axiom.Config) {
+		cfg.Step("create users", func() {
+			steps := map[string]func(cfg *axiom.Config){}
+			for _, name := range names {
+				steps[name] = func(cfg *axiom.Config) {
+					started.Done()
+					started.Wait()
+
+					cfg.Step("insert "+name, func() {})
+				}
+			}
+			cfg.ParallelSteps(steps)
+		})
+	})
+
+	snapshot := memoryWriter.Snapshot()
+	require.Len(t, snapshot.Results, 1)
+
+	result := snapshot.Results[0]
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, "create users", result.Steps[0].Name)
+
+	branches := map[string]int{}
+	for i, step := range result.Steps[0].Steps {
+		branches[step.Name] = i
+	}
+	require.Len(t, branches, len(names))
+	for _, name := range names {
+		i, ok := branches[name]
+		require.True(t, ok, "missing branch %q", name)
+		branch := result.Steps[0].Steps[i]
+		require.Len(t, branch.Steps, 1)
+		assert.Equal(t, "insert "+name, branch.Steps[0].Name)
+	}
+}
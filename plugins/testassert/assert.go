@@ -1,13 +1,11 @@
 package testassert
 
 import (
-	"testing"
-
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/stretchr/testify/assert"
 )
 
-func HandleAssert(t *testing.T, a axiom.Assert) {
+func HandleAssert(t assert.TestingT, a axiom.Assert) {
 	switch a.Type {
 
 	case axiom.AssertEqual:
@@ -30,5 +28,45 @@ func HandleAssert(t *testing.T, a axiom.Assert) {
 
 	case axiom.AssertNotNil:
 		assert.NotNil(t, a.Actual, a.Message)
+
+	case axiom.AssertContains:
+		assert.Contains(t, a.Actual, a.Expected, a.Message)
+
+	case axiom.AssertNotContains:
+		assert.NotContains(t, a.Actual, a.Expected, a.Message)
+
+	case axiom.AssertLen:
+		assert.Len(t, a.Actual, a.Expected.(int), a.Message)
+
+	case axiom.AssertGreater:
+		assert.Greater(t, a.Actual, a.Expected, a.Message)
+
+	case axiom.AssertLess:
+		assert.Less(t, a.Actual, a.Expected, a.Message)
+
+	case axiom.AssertInDelta:
+		assert.InDelta(t, a.Expected, a.Actual, a.Delta, a.Message)
+
+	case axiom.AssertRegexp:
+		assert.Regexp(t, a.Expected, a.Actual, a.Message)
+
+	case axiom.AssertElementsMatch:
+		assert.ElementsMatch(t, a.Expected, a.Actual, a.Message)
+
+	case axiom.AssertJSONEqual:
+		assert.JSONEq(t, a.Expected.(string), a.Actual.(string), a.Message)
+
+	case axiom.AssertErrorIs:
+		target, _ := a.Expected.(error)
+		assert.ErrorIs(t, a.Error, target, a.Message)
+
+	case axiom.AssertErrorAs:
+		assert.ErrorAs(t, a.Error, a.Expected, a.Message)
+
+	case axiom.AssertEventually:
+		assert.Eventually(t, a.Condition, a.WaitFor, a.Tick, a.Message)
+
+	case axiom.AssertPanics:
+		assert.Panics(t, a.Action, a.Message)
 	}
 }
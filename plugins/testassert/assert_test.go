@@ -1,7 +1,12 @@
 package testassert_test
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/Nikita-Filonov/axiom/plugins/testassert"
@@ -98,3 +103,105 @@ func TestHandleAssert_NotNil_Pass(t *testing.T) {
 		t.Fatalf("expected assert to pass")
 	}
 }
+
+type notFoundError struct{}
+
+func (notFoundError) Error() string { return "not found" }
+
+type recordingT struct{ failures []string }
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestHandleAssert_Extended(t *testing.T) {
+	wrapped := fmt.Errorf("load: %w", notFoundError{})
+
+	tests := []struct {
+		name   string
+		pass   axiom.Assert
+		failed axiom.Assert
+	}{
+		{
+			name:   "contains",
+			pass:   axiom.NewContainsAssert([]string{"a", "b"}, "b", "contains"),
+			failed: axiom.NewContainsAssert("hello", "x", "contains"),
+		},
+		{
+			name:   "not contains",
+			pass:   axiom.NewNotContainsAssert("hello", "x", "not contains"),
+			failed: axiom.NewNotContainsAssert(map[string]int{"a": 1}, "a", "not contains"),
+		},
+		{
+			name:   "len",
+			pass:   axiom.NewLenAssert([]int{1, 2}, 2, "len"),
+			failed: axiom.NewLenAssert("abc", 2, "len"),
+		},
+		{
+			name:   "greater",
+			pass:   axiom.NewGreaterAssert(5, 3, "greater"),
+			failed: axiom.NewGreaterAssert(3, 5, "greater"),
+		},
+		{
+			name:   "less",
+			pass:   axiom.NewLessAssert(1.5, 2.0, "less"),
+			failed: axiom.NewLessAssert(2.0, 1.5, "less"),
+		},
+		{
+			name:   "in delta",
+			pass:   axiom.NewInDeltaAssert(1.0, 1.05, 0.1, "in delta"),
+			failed: axiom.NewInDeltaAssert(1.0, 1.5, 0.1, "in delta"),
+		},
+		{
+			name:   "regexp",
+			pass:   axiom.NewRegexpAssert(regexp.MustCompile(`^id-\d+$`), "id-42", "regexp"),
+			failed: axiom.NewRegexpAssert(`^id-\d+$`, "name", "regexp"),
+		},
+		{
+			name:   "elements match",
+			pass:   axiom.NewElementsMatchAssert([]int{1, 2, 3}, []int{3, 1, 2}, "elements"),
+			failed: axiom.NewElementsMatchAssert([]int{1, 2}, []int{1, 3}, "elements"),
+		},
+		{
+			name:   "json equal",
+			pass:   axiom.NewJSONEqualAssert(`{"a":1,"b":2}`, `{"b":2,"a":1}`, "json"),
+			failed: axiom.NewJSONEqualAssert(`{"a":1}`, `{"a":2}`, "json"),
+		},
+		{
+			name:   "error is",
+			pass:   axiom.NewErrorIsAssert(fmt.Errorf("wrap: %w", io.EOF), io.EOF, "error is"),
+			failed: axiom.NewErrorIsAssert(errors.New("other"), io.EOF, "error is"),
+		},
+		{
+			name:   "error as",
+			pass:   axiom.NewErrorAsAssert(wrapped, new(notFoundError), "error as"),
+			failed: axiom.NewErrorAsAssert(io.EOF, new(notFoundError), "error as"),
+		},
+		{
+			name:   "eventually",
+			pass:   axiom.NewEventuallyAssert(func() bool { return true }, time.Second, time.Millisecond, "eventually"),
+			failed: axiom.NewEventuallyAssert(func() bool { return false }, 20*time.Millisecond, 5*time.Millisecond, "eventually"),
+		},
+		{
+			name:   "panics",
+			pass:   axiom.NewPanicsAssert(func() { panic("boom") }, "panics"),
+			failed: axiom.NewPanicsAssert(func() {}, "panics"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pass := &recordingT{}
+			testassert.HandleAssert(pass, tt.pass)
+			if len(pass.failures) != 0 {
+				t.Fatalf("expected assert to pass, got %v", pass.failures)
+			}
+
+			failed := &recordingT{}
+			testassert.HandleAssert(failed, tt.failed)
+			if len(failed.failures) == 0 {
+				t.Fatalf("expected assert to fail")
+			}
+		})
+	}
+}
@@ -1,17 +1,62 @@
 package testassert
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Nikita-Filonov/axiom"
 )
 
 func Plugin() axiom.Plugin {
 	return func(cfg *axiom.Config) {
+		cfg.Runtime.EmitAssertEvaluator(Evaluate)
 		cfg.Runtime.EmitAssertSink(func(a axiom.Assert) {
 			if cfg.SubT == nil {
 				return
 			}
 
-			HandleAssert(cfg.SubT, a)
+			// Failures go through Config so soft scopes and quarantine can
+			// intercept them.
+			if a.Result != nil {
+				if !a.Result.Passed {
+					cfg.AssertFailed(a, a.Result.Failure)
+				}
+				return
+			}
+			HandleAssert(assertT{cfg: cfg, assert: a}, a)
 		})
 	}
 }
+
+// Evaluate runs the assertion through testify and keeps its failure message;
+// the diff is rendered by axiom so it looks the same for every backend.
+func Evaluate(a axiom.Assert) axiom.AssertResult {
+	recorder := &recordingT{}
+	HandleAssert(recorder, a)
+
+	if len(recorder.failures) == 0 {
+		return axiom.AssertResult{Passed: true}
+	}
+
+	return axiom.AssertResult{
+		Failure: strings.Join(recorder.failures, "\n"),
+		Diff:    axiom.AssertDiff(a),
+	}
+}
+
+type assertT struct {
+	cfg    *axiom.Config
+	assert axiom.Assert
+}
+
+func (t assertT) Errorf(format string, args ...any) {
+	t.cfg.AssertFailed(t.assert, fmt.Sprintf(format, args...))
+}
+
+type recordingT struct {
+	failures []string
+}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.failures = append(t.failures, fmt.Sprintf(format, args...))
+}
@@ -1,6 +1,7 @@
 package testassert_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -24,3 +25,86 @@ func TestPlugin_AssertSink_NoSubT_DoesNothing(t *testing.T) {
 		t.Fatalf("expected assert sink to be called")
 	}
 }
+
+func TestPlugin_AssertSink_QuarantinedFailureDoesNotFailTest(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT:       fakeT,
+		Quarantine: axiom.NewQuarantine(axiom.QuarantineBecause("flaky")),
+	}
+
+	testassert.Plugin()(cfg)
+
+	cfg.Runtime.Assert(axiom.NewEqualAssert(1, 2, "values must be equal"))
+
+	if fakeT.Failed() {
+		t.Fatalf("quarantined assertion must not fail the test")
+	}
+	if len(cfg.QuarantinedFailures()) != 1 {
+		t.Fatalf("expected one quarantined failure, got %d", len(cfg.QuarantinedFailures()))
+	}
+}
+
+func TestPlugin_AssertSink_SoftScopeReportsFailuresOnce(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT}
+
+	testassert.Plugin()(cfg)
+
+	cfg.SoftStep("check values", func() {
+		cfg.Assert(axiom.NewEqualAssert(1, 2, "first"))
+		cfg.Assert(axiom.NewTrueAssert(false, "second"))
+		cfg.Assert(axiom.NewEqualAssert(3, 3, "third"))
+	})
+
+	failures := cfg.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("expected one aggregated failure, got %d: %v", len(failures), failures)
+	}
+	for _, want := range []string{`2 soft assertion(s) failed in step "check values"`, `1) equal "first"`, `2) true "second"`} {
+		if !strings.Contains(failures[0], want) {
+			t.Fatalf("expected report to contain %q, got:\n%s", want, failures[0])
+		}
+	}
+	if !fakeT.Failed() {
+		t.Fatalf("expected soft failures to fail the test")
+	}
+}
+
+func TestPlugin_EvaluatesAssertOnceWithTestify(t *testing.T) {
+	fakeT := &testing.T{}
+	calls := 0
+	var received axiom.Assert
+
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeAssertSink(func(a axiom.Assert) { received = a }),
+		),
+	}
+
+	testassert.Plugin()(cfg)
+
+	cfg.Assert(axiom.NewPanicsAssert(func() { calls++ }, "must panic"))
+
+	if calls != 1 {
+		t.Fatalf("expected action to run in a single evaluation, got %d calls", calls)
+	}
+	if !received.Failed() || !strings.Contains(received.Result.Failure, "should panic") {
+		t.Fatalf("expected testify failure in result, got %+v", received.Result)
+	}
+	if !fakeT.Failed() {
+		t.Fatalf("expected failed assert to fail the test")
+	}
+}
+
+func TestEvaluate_DiffRenderedByAxiom(t *testing.T) {
+	result := testassert.Evaluate(axiom.NewJSONEqualAssert(`{"a":1}`, `{"a":2}`, "json"))
+
+	if result.Passed {
+		t.Fatalf("expected failure")
+	}
+	if result.Diff != "  {\n-   \"a\": 1\n+   \"a\": 2\n  }" {
+		t.Fatalf("unexpected diff:\n%s", result.Diff)
+	}
+}
@@ -34,9 +34,11 @@ func ExplainRunner(r *axiom.Runner) Explanation {
 		Meta:      meta,
 		Skip:      explainSkip(r.Skip),
 		Retry:     explainRetry(retry),
+		Timeout:   explainTimeout(r.Timeout),
 		Parallel:  explainParallel(r.Parallel),
 		Context:   explainContext(context),
 		Fixtures:  sortedMapKeys(fixtures.Registry),
+		Graph:     explainFixtureGraph(fixtures),
 		Resources: sortedMapKeys(resources.Registry),
 		Hooks:     explainHooks(r.Hooks),
 		Plugins: PluginsExplanation{
@@ -104,9 +106,11 @@ func ExplainConfig(c *axiom.Config) Explanation {
 		Meta:      meta,
 		Skip:      explainSkip(c.Skip),
 		Retry:     explainRetry(retry),
+		Timeout:   explainTimeout(c.Timeout),
 		Parallel:  explainParallel(c.Parallel),
 		Context:   explainContext(context),
 		Fixtures:  sortedMapKeys(fixtures.Registry),
+		Graph:     explainFixtureGraph(fixtures),
 		Resources: sortedMapKeys(resources.Registry),
 		Hooks:     explainHooks(c.Hooks),
 		Plugins: PluginsExplanation{
@@ -21,6 +21,17 @@ func explainRetry(r axiom.Retry) RetryExplanation {
 		Times:            r.Times,
 		Delay:            r.Delay.String(),
 		DelayNanoseconds: int64(r.Delay),
+		Backoff:          callableName(r.Backoff),
+		RetryOn:          callableName(r.RetryOn),
+	}
+}
+
+func explainTimeout(t axiom.Timeout) TimeoutExplanation {
+	return TimeoutExplanation{
+		Case:            t.Case.String(),
+		CaseNanoseconds: int64(t.Case),
+		Step:            t.Step.String(),
+		StepNanoseconds: int64(t.Step),
 	}
 }
 
@@ -49,12 +60,37 @@ func explainHooks(h axiom.Hooks) HooksExplanation {
 	}
 }
 
+func explainFixtureGraph(f axiom.Fixtures) FixtureGraphExplanation {
+	graph := FixtureGraphExplanation{}
+	for name, deps := range f.Deps {
+		if graph.Deps == nil {
+			graph.Deps = map[string][]string{}
+		}
+		graph.Deps[name] = append([]string{}, deps...)
+	}
+	for name, scope := range f.Scopes {
+		if graph.Scopes == nil {
+			graph.Scopes = map[string]string{}
+		}
+		graph.Scopes[name] = scope.String()
+	}
+
+	if err := f.Validate(); err != nil {
+		graph.Error = err.Error()
+		return graph
+	}
+
+	graph.Order, _ = f.Order(sortedMapKeys(f.Registry)...)
+	return graph
+}
+
 func explainRuntime(r axiom.Runtime) RuntimeExplanation {
 	return RuntimeExplanation{
 		TestWraps:     explainCallables(r.TestWraps),
 		StepWraps:     explainCallables(r.StepWraps),
 		SetupWraps:    explainCallables(r.SetupWraps),
 		TeardownWraps: explainCallables(r.TeardownWraps),
+		SpanWraps:     explainCallables(r.SpanWraps),
 		LogSinks:      explainCallables(r.LogSinks),
 		AssertSinks:   explainCallables(r.AssertSinks),
 		ArtefactSinks: explainCallables(r.ArtefactSinks),
@@ -10,19 +10,21 @@ const (
 )
 
 type Explanation struct {
-	Kind      ExplanationKind     `json:"kind"`
-	Runner    *RunnerExplanation  `json:"runner,omitempty"`
-	Case      *CaseExplanation    `json:"case,omitempty"`
-	Meta      axiom.Meta          `json:"meta"`
-	Skip      SkipExplanation     `json:"skip"`
-	Retry     RetryExplanation    `json:"retry"`
-	Parallel  ParallelExplanation `json:"parallel"`
-	Context   ContextExplanation  `json:"context"`
-	Fixtures  []string            `json:"fixtures"`
-	Resources []string            `json:"resources"`
-	Hooks     HooksExplanation    `json:"hooks"`
-	Plugins   PluginsExplanation  `json:"plugins"`
-	Runtime   RuntimeExplanation  `json:"runtime"`
+	Kind      ExplanationKind         `json:"kind"`
+	Runner    *RunnerExplanation      `json:"runner,omitempty"`
+	Case      *CaseExplanation        `json:"case,omitempty"`
+	Meta      axiom.Meta              `json:"meta"`
+	Skip      SkipExplanation         `json:"skip"`
+	Retry     RetryExplanation        `json:"retry"`
+	Timeout   TimeoutExplanation      `json:"timeout"`
+	Parallel  ParallelExplanation     `json:"parallel"`
+	Context   ContextExplanation      `json:"context"`
+	Fixtures  []string                `json:"fixtures"`
+	Graph     FixtureGraphExplanation `json:"fixtureGraph"`
+	Resources []string                `json:"resources"`
+	Hooks     HooksExplanation        `json:"hooks"`
+	Plugins   PluginsExplanation      `json:"plugins"`
+	Runtime   RuntimeExplanation      `json:"runtime"`
 }
 
 type RunnerExplanation struct {
@@ -49,6 +51,15 @@ type RetryExplanation struct {
 	Times            int    `json:"times"`
 	Delay            string `json:"delay"`
 	DelayNanoseconds int64  `json:"delayNanoseconds"`
+	Backoff          string `json:"backoff,omitempty"`
+	RetryOn          string `json:"retryOn,omitempty"`
+}
+
+type TimeoutExplanation struct {
+	Case            string `json:"case"`
+	CaseNanoseconds int64  `json:"caseNanoseconds"`
+	Step            string `json:"step"`
+	StepNanoseconds int64  `json:"stepNanoseconds"`
 }
 
 type ParallelExplanation struct {
@@ -63,6 +74,13 @@ type ContextExplanation struct {
 	DataKeys []string `json:"dataKeys"`
 }
 
+type FixtureGraphExplanation struct {
+	Deps   map[string][]string `json:"deps,omitempty"`
+	Scopes map[string]string   `json:"scopes,omitempty"`
+	Order  []string            `json:"order,omitempty"`
+	Error  string              `json:"error,omitempty"`
+}
+
 type HooksExplanation struct {
 	BeforeAll  CallableExplanation `json:"beforeAll"`
 	AfterAll   CallableExplanation `json:"afterAll"`
@@ -83,6 +101,7 @@ type RuntimeExplanation struct {
 	StepWraps     CallableExplanation `json:"stepWraps"`
 	SetupWraps    CallableExplanation `json:"setupWraps"`
 	TeardownWraps CallableExplanation `json:"teardownWraps"`
+	SpanWraps     CallableExplanation `json:"spanWraps"`
 	LogSinks      CallableExplanation `json:"logSinks"`
 	AssertSinks   CallableExplanation `json:"assertSinks"`
 	ArtefactSinks CallableExplanation `json:"artefactSinks"`
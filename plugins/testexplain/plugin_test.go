@@ -1,7 +1,9 @@
 package testexplain_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/Nikita-Filonov/axiom/plugins/testexplain"
@@ -40,6 +42,7 @@ func TestExplainRunner_IncludesRunnerShape(t *testing.T) {
 		),
 		axiom.WithRunnerContext(axiom.WithContextData("key", "value")),
 		axiom.WithRunnerRetry(axiom.WithRetryTimes(2)),
+		axiom.WithRunnerTimeout(axiom.WithTimeoutStep(time.Second)),
 		axiom.WithRunnerParallel(axiom.WithParallelEnabled()),
 	)
 
@@ -66,6 +69,9 @@ func TestExplainRunner_IncludesRunnerShape(t *testing.T) {
 	if explanation.Retry.Times != 2 {
 		t.Fatalf("unexpected retry times: %d", explanation.Retry.Times)
 	}
+	if explanation.Timeout.Step != "1s" || explanation.Timeout.Case != "0s" {
+		t.Fatalf("unexpected timeout explanation: %#v", explanation.Timeout)
+	}
 	if !explanation.Parallel.Enabled {
 		t.Fatal("expected parallel to be enabled")
 	}
@@ -124,3 +130,67 @@ func TestExplainerSnapshot_IsIndependent(t *testing.T) {
 		t.Fatalf("snapshot mutation changed explainer: %s", again[0].Kind)
 	}
 }
+
+func TestExplainConfig_IncludesRetryPolicy(t *testing.T) {
+	cfg := &axiom.Config{
+		Retry: axiom.NewRetry(
+			axiom.WithRetryBackoff(axiom.NewExponentialBackoff(0)),
+			axiom.WithRetryOn(axiom.RetryOnMessage("connection reset")),
+		),
+	}
+
+	explanation := testexplain.ExplainConfig(cfg)
+
+	if !strings.Contains(explanation.Retry.Backoff, "NewExponentialBackoff") {
+		t.Fatalf("unexpected retry backoff: %q", explanation.Retry.Backoff)
+	}
+	if !strings.Contains(explanation.Retry.RetryOn, "RetryOnMessage") {
+		t.Fatalf("unexpected retry predicate: %q", explanation.Retry.RetryOn)
+	}
+}
+
+func TestExplainConfig_IncludesFixtureGraph(t *testing.T) {
+	noop := func(cfg *axiom.Config) (any, func(), error) { return nil, nil, nil }
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("db", noop),
+		axiom.WithRunnerFixture("user", noop),
+		axiom.WithRunnerFixtureDeps("user", "db"),
+		axiom.WithRunnerFixtureScope("db", axiom.FixtureScopeRunner),
+	)
+	c := axiom.NewCase(
+		axiom.WithCaseFixture("admin", noop),
+		axiom.WithCaseFixtureDeps("admin", "user"),
+	)
+
+	explanation := testexplain.ExplainConfig(r.BuildConfig(t, &c))
+
+	graph := explanation.Graph
+	if graph.Error != "" {
+		t.Fatalf("unexpected graph error: %s", graph.Error)
+	}
+	if strings.Join(graph.Order, ",") != "db,user,admin" {
+		t.Fatalf("unexpected fixture order: %#v", graph.Order)
+	}
+	if len(graph.Deps) != 2 || graph.Deps["admin"][0] != "user" {
+		t.Fatalf("unexpected fixture deps: %#v", graph.Deps)
+	}
+	if graph.Scopes["db"] != "runner" {
+		t.Fatalf("unexpected fixture scopes: %#v", graph.Scopes)
+	}
+}
+
+func TestExplainRunner_ReportsInvalidFixtureGraph(t *testing.T) {
+	r := axiom.NewRunner(
+		axiom.WithRunnerFixture("user", func(cfg *axiom.Config) (any, func(), error) { return nil, nil, nil }),
+		axiom.WithRunnerFixtureDeps("user", "db"),
+	)
+
+	explanation := testexplain.ExplainRunner(r)
+
+	if explanation.Graph.Error != `fixture "user" depends on unknown fixture "db"` {
+		t.Fatalf("unexpected graph error: %q", explanation.Graph.Error)
+	}
+	if explanation.Graph.Order != nil {
+		t.Fatalf("expected no order for invalid graph, got %#v", explanation.Graph.Order)
+	}
+}
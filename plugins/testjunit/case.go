@@ -0,0 +1,98 @@
+package testjunit
+
+import (
+	"strings"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+const (
+	StatusPassed  = "passed"
+	StatusFailed  = "failed"
+	StatusSkipped = "skipped"
+)
+
+type AttemptResult struct {
+	Number      int
+	Status      string
+	Start       time.Time
+	Duration    time.Duration
+	SkipReason  string
+	Failures    []string
+	Quarantined []string
+	Logs        []string
+}
+
+type CaseResult struct {
+	ID       string
+	Name     string
+	Suite    string
+	Class    string
+	Meta     axiom.Meta
+	Attempts []AttemptResult
+}
+
+func NewCaseResult(cfg *axiom.Config) *CaseResult {
+	result := &CaseResult{Meta: cfg.Meta.Copy()}
+	if cfg.Case != nil {
+		result.ID = cfg.Case.ID
+		result.Name = cfg.Case.Name
+	}
+
+	fallback := "axiom"
+	if cfg.RootT != nil {
+		fallback = cfg.RootT.Name()
+	}
+
+	hierarchy := suiteHierarchy(cfg.Meta)
+	if len(hierarchy) == 0 {
+		result.Suite = fallback
+		result.Class = fallback
+		return result
+	}
+
+	result.Suite = strings.Join(hierarchy, " / ")
+	result.Class = strings.Join(hierarchy, ".")
+	return result
+}
+
+func (r *CaseResult) Final() AttemptResult {
+	if len(r.Attempts) == 0 {
+		return AttemptResult{}
+	}
+
+	return r.Attempts[len(r.Attempts)-1]
+}
+
+func (r *CaseResult) Duration() time.Duration {
+	var total time.Duration
+	for _, attempt := range r.Attempts {
+		total += attempt.Duration
+	}
+
+	return total
+}
+
+func caseKey(cfg *axiom.Config) string {
+	name := ""
+	if cfg.Case != nil {
+		name = cfg.Case.Name
+	}
+	if cfg.RootT == nil {
+		return name
+	}
+
+	return cfg.RootT.Name() + "/" + name
+}
+
+func suiteHierarchy(meta axiom.Meta) []string {
+	var hierarchy []string
+	for _, name := range []string{meta.ParentSuite, meta.Suite, meta.SubSuite} {
+		if name != "" {
+			hierarchy = append(hierarchy, name)
+		}
+	}
+
+	return hierarchy
+}
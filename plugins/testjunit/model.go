@@ -0,0 +1,67 @@
+package testjunit
+
+import "encoding/xml"
+
+type TestSuites struct {
+	XMLName  xml.Name    `xml:"testsuites"`
+	Name     string      `xml:"name,attr,omitempty"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Errors   int         `xml:"errors,attr"`
+	Skipped  int         `xml:"skipped,attr"`
+	Time     string      `xml:"time,attr"`
+	Suites   []TestSuite `xml:"testsuite"`
+}
+
+type TestSuite struct {
+	Name      string     `xml:"name,attr"`
+	Tests     int        `xml:"tests,attr"`
+	Failures  int        `xml:"failures,attr"`
+	Errors    int        `xml:"errors,attr"`
+	Skipped   int        `xml:"skipped,attr"`
+	Time      string     `xml:"time,attr"`
+	Timestamp string     `xml:"timestamp,attr,omitempty"`
+	Cases     []TestCase `xml:"testcase"`
+}
+
+type TestCase struct {
+	Name          string       `xml:"name,attr"`
+	ClassName     string       `xml:"classname,attr"`
+	Time          string       `xml:"time,attr"`
+	Properties    *Properties  `xml:"properties,omitempty"`
+	Skipped       *Skipped     `xml:"skipped,omitempty"`
+	Failure       *Failure     `xml:"failure,omitempty"`
+	FlakyFailures []RerunEntry `xml:"flakyFailure,omitempty"`
+	RerunFailures []RerunEntry `xml:"rerunFailure,omitempty"`
+	SystemOut     string       `xml:"system-out,omitempty"`
+}
+
+type Properties struct {
+	Items []Property `xml:"property"`
+}
+
+type Property struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type Skipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+type Failure struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// RerunEntry follows the Maven Surefire rerun format understood by Jenkins and
+// GitLab: flakyFailure for attempts that were followed by a pass, rerunFailure
+// for earlier attempts of a case that never passed.
+type RerunEntry struct {
+	Message   string `xml:"message,attr,omitempty"`
+	Type      string `xml:"type,attr,omitempty"`
+	Time      string `xml:"time,attr,omitempty"`
+	Text      string `xml:",chardata"`
+	SystemOut string `xml:"system-out,omitempty"`
+}
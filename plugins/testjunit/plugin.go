@@ -0,0 +1,119 @@
+package testjunit
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+func Plugin(report *Report) axiom.Plugin {
+	return func(cfg *axiom.Config) {
+		recorder := &attemptRecorder{report: report, cfg: cfg}
+
+		cfg.Runtime.EmitEventSink(recorder.handleEvent)
+		cfg.Runtime.EmitLogSink(recorder.handleLog)
+	}
+}
+
+type attemptRecorder struct {
+	mu      sync.Mutex
+	report  *Report
+	cfg     *axiom.Config
+	attempt AttemptResult
+}
+
+// handleEvent builds the attempt from the attempt span, so attempts skipped
+// before the test action starts are recorded as well.
+func (r *attemptRecorder) handleEvent(e axiom.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch e.Type {
+	case axiom.EventTypeAttemptStart:
+		r.attempt = AttemptResult{Number: r.cfg.Attempt, Start: time.Now()}
+
+	case axiom.EventTypeAttemptFinish:
+		r.finalize(e)
+
+	case axiom.EventTypeCasePanic,
+		axiom.EventTypeCaseTimeout,
+		axiom.EventTypeStepPanic,
+		axiom.EventTypeStepTimeout,
+		axiom.EventTypeSetupPanic,
+		axiom.EventTypeTeardownPanic,
+		axiom.EventTypeFixtureSetupFailed,
+		axiom.EventTypeFixtureCleanupPanic:
+		r.attempt.Failures = append(r.attempt.Failures, formatFailure(e))
+
+	case axiom.EventTypeAssertFailed:
+		r.attempt.Failures = append(r.attempt.Failures, formatAssertFailure(e))
+
+	case axiom.EventTypeCaseFailed:
+		// Failures reported through cfg.Errorf and cfg.Fatalf only, e.g.
+		// fixture and resource errors, have no event of their own.
+		if len(r.attempt.Failures) == 0 && e.Message != "" {
+			r.attempt.Failures = strings.Split(e.Message, "\n")
+		}
+
+	case axiom.EventTypeCaseSkipped:
+		if len(r.attempt.Quarantined) == 0 {
+			r.attempt.SkipReason = e.Message
+		}
+
+	case axiom.EventTypeCaseQuarantined:
+		r.attempt.Quarantined = append(r.attempt.Quarantined, e.Message)
+		r.attempt.SkipReason = "quarantined"
+		if e.Name != "" {
+			r.attempt.SkipReason = e.Name
+		}
+	}
+}
+
+func (r *attemptRecorder) handleLog(l axiom.Log) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempt.Logs = append(r.attempt.Logs, fmt.Sprintf("[%s] %s", l.Level, l.Text))
+}
+
+func (r *attemptRecorder) finalize(e axiom.Event) {
+	r.attempt.Duration = e.Duration
+	switch e.Message {
+	case StatusPassed:
+		r.attempt.Status = StatusPassed
+		r.attempt.Failures = nil
+	case StatusFailed:
+		r.attempt.Status = StatusFailed
+	default:
+		// skipped and quarantined attempts
+		r.attempt.Status = StatusSkipped
+		r.attempt.Failures = nil
+	}
+
+	r.report.Record(r.cfg, r.attempt)
+}
+
+func formatFailure(e axiom.Event) string {
+	if e.Name == "" {
+		return fmt.Sprintf("%s: %s", e.Type, e.Message)
+	}
+
+	return fmt.Sprintf("%s %q: %s", e.Type, e.Name, e.Message)
+}
+
+func formatAssertFailure(e axiom.Event) string {
+	failure := ""
+	for _, attr := range e.Attrs {
+		if attr.Key == axiom.AssertAttrFailure {
+			failure = fmt.Sprint(attr.Value)
+		}
+	}
+	if e.Message != "" {
+		failure = e.Message + ": " + failure
+	}
+
+	return fmt.Sprintf("%s %q: %s", e.Type, e.Name, failure)
+}
@@ -0,0 +1,170 @@
+package testjunit_test
+
+import (
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testjunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlugin_RecordsPassedAttemptWithLogs(t *testing.T) {
+	report := testjunit.NewReport()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testjunit.Plugin(report)))
+
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseID("AX-1"),
+		axiom.WithCaseName("passed"),
+		axiom.WithCaseMeta(axiom.WithMetaParentSuite("api"), axiom.WithMetaSuite("users")),
+	), func(cfg *axiom.Config) {
+		cfg.Log(axiom.NewInfoLog("created user"))
+	})
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	assert.Equal(t, "AX-1", cases[0].ID)
+	assert.Equal(t, "passed", cases[0].Name)
+	assert.Equal(t, "api / users", cases[0].Suite)
+	assert.Equal(t, "api.users", cases[0].Class)
+	require.Len(t, cases[0].Attempts, 1)
+	assert.Equal(t, 1, cases[0].Attempts[0].Number)
+	assert.Equal(t, testjunit.StatusPassed, cases[0].Attempts[0].Status)
+	assert.Equal(t, []string{"[info] created user"}, cases[0].Attempts[0].Logs)
+}
+
+func TestPlugin_RecordsRuntimeSkip(t *testing.T) {
+	report := testjunit.NewReport()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testjunit.Plugin(report)))
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("skipped")), func(cfg *axiom.Config) {
+		cfg.T().Skip("not today")
+	})
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	assert.Equal(t, t.Name(), cases[0].Suite)
+	assert.Equal(t, testjunit.StatusSkipped, cases[0].Final().Status)
+}
+
+func TestPlugin_RecordsFailuresFromPanicEvents(t *testing.T) {
+	report := testjunit.NewReport()
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{Name: "failed"},
+		Attempt: 1,
+		SubT:    &testing.T{},
+	}
+
+	testjunit.Plugin(report)(cfg)
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptStart))
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("create user", func() { panic("connection refused") })
+	})
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage("failed")))
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	assert.Equal(t, testjunit.StatusFailed, cases[0].Final().Status)
+	assert.Equal(t, []string{`step.panic "create user": connection refused`}, cases[0].Final().Failures)
+}
+
+func TestPlugin_RecordsFailuresFromAssertEvents(t *testing.T) {
+	report := testjunit.NewReport()
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{Name: "failed"},
+		Attempt: 1,
+		SubT:    &testing.T{},
+	}
+
+	testjunit.Plugin(report)(cfg)
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptStart))
+	cfg.Assert(axiom.NewEqualAssert(201, 500, "status code"))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage("status code")))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage("failed")))
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	require.Len(t, cases[0].Final().Failures, 1)
+	assert.Contains(t, cases[0].Final().Failures[0], `assert.failed "equal": status code: `)
+}
+
+func TestPlugin_RecordsErrorfFailures(t *testing.T) {
+	report := testjunit.NewReport()
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{Name: "failed"},
+		Attempt: 1,
+		SubT:    &testing.T{},
+	}
+
+	testjunit.Plugin(report)(cfg)
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptStart))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage("status 500\nno user")))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage("failed")))
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	assert.Equal(t, []string{"status 500", "no user"}, cases[0].Final().Failures)
+}
+
+func TestPlugin_RecordsCaseSkippedBeforeExecution(t *testing.T) {
+	report := testjunit.NewReport()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testjunit.Plugin(report)))
+
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseName("skipped"),
+		axiom.WithCaseSkip(axiom.SkipBecause("not applicable")),
+	), func(cfg *axiom.Config) {})
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	assert.Equal(t, testjunit.StatusSkipped, cases[0].Final().Status)
+	assert.Equal(t, "not applicable", cases[0].Final().SkipReason)
+}
+
+func TestPlugin_GroupsAttemptsOfTheSameCase(t *testing.T) {
+	report := testjunit.NewReport()
+	testCase := &axiom.Case{Name: "flaky"}
+
+	for attempt, fail := range []bool{true, false} {
+		cfg := &axiom.Config{Case: testCase, Attempt: attempt + 1, SubT: &testing.T{}}
+		testjunit.Plugin(report)(cfg)
+		cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptStart))
+		cfg.Test(func(c *axiom.Config) {
+			if fail {
+				panic("timeout")
+			}
+		})
+
+		status := testjunit.StatusPassed
+		if fail {
+			status = testjunit.StatusFailed
+		}
+		cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage(status)))
+	}
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	require.Len(t, cases[0].Attempts, 2)
+	assert.Equal(t, testjunit.StatusFailed, cases[0].Attempts[0].Status)
+	assert.Equal(t, testjunit.StatusPassed, cases[0].Attempts[1].Status)
+}
+
+func TestPlugin_RecordsQuarantinedFailureAsSkipped(t *testing.T) {
+	report := testjunit.NewReport()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testjunit.Plugin(report)))
+
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseName("quarantined"),
+		axiom.WithCaseQuarantine(axiom.QuarantineBecause("JIRA-1")),
+	), func(cfg *axiom.Config) {
+		cfg.Step("flaky", func() { panic("connection reset") })
+	})
+
+	cases := report.Cases()
+	require.Len(t, cases, 1)
+	final := cases[0].Final()
+	assert.Equal(t, testjunit.StatusSkipped, final.Status)
+	assert.Equal(t, "JIRA-1", final.SkipReason)
+	assert.Empty(t, final.Failures)
+	assert.Equal(t, []string{`panic in step "flaky": connection reset`}, final.Quarantined)
+}
@@ -0,0 +1,217 @@
+package testjunit
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+const (
+	AxiomJUnitReportPath = "AXIOM_JUNIT_REPORT_PATH"
+	DefaultReportPath    = "junit.xml"
+)
+
+type Report struct {
+	mu    sync.Mutex
+	path  string
+	name  string
+	cases []*CaseResult
+	index map[string]*CaseResult
+}
+
+type ReportOption func(*Report)
+
+func NewReport(options ...ReportOption) *Report {
+	r := &Report{path: DefaultReportPath, index: map[string]*CaseResult{}}
+	for _, option := range options {
+		option(r)
+	}
+
+	return r
+}
+
+func WithReportPath(path string) ReportOption {
+	return func(r *Report) { r.path = path }
+}
+
+func WithReportName(name string) ReportOption {
+	return func(r *Report) { r.name = name }
+}
+
+func ReportPathFromEnv() ReportOption {
+	return func(r *Report) {
+		if path := os.Getenv(AxiomJUnitReportPath); path != "" {
+			r.path = path
+		}
+	}
+}
+
+func (r *Report) Record(cfg *axiom.Config, attempt AttemptResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := caseKey(cfg)
+	result, ok := r.index[key]
+	if !ok {
+		result = NewCaseResult(cfg)
+		r.index[key] = result
+		r.cases = append(r.cases, result)
+	}
+
+	result.Attempts = append(result.Attempts, attempt)
+}
+
+func (r *Report) Cases() []CaseResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cases := make([]CaseResult, len(r.cases))
+	for i, result := range r.cases {
+		cases[i] = *result
+		cases[i].Attempts = append([]AttemptResult{}, result.Attempts...)
+	}
+
+	return cases
+}
+
+func (r *Report) Build() TestSuites {
+	root := TestSuites{Name: r.name}
+	suites := map[string]*TestSuite{}
+	durations := map[string]time.Duration{}
+	var order []string
+
+	for _, result := range r.Cases() {
+		suite, ok := suites[result.Suite]
+		if !ok {
+			suite = &TestSuite{Name: result.Suite}
+			suites[result.Suite] = suite
+			order = append(order, result.Suite)
+		}
+
+		testCase := buildTestCase(result)
+		durations[result.Suite] += result.Duration()
+		suite.Cases = append(suite.Cases, testCase)
+		suite.Tests++
+		switch {
+		case testCase.Skipped != nil:
+			suite.Skipped++
+		case testCase.Failure != nil:
+			suite.Failures++
+		}
+
+		start := result.Attempts[0].Start.Format(time.RFC3339)
+		if suite.Timestamp == "" || start < suite.Timestamp {
+			suite.Timestamp = start
+		}
+	}
+
+	sort.Strings(order)
+
+	var total time.Duration
+	for _, name := range order {
+		suite := suites[name]
+		suite.Time = formatSeconds(durations[name])
+		total += durations[name]
+
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Skipped += suite.Skipped
+		root.Suites = append(root.Suites, *suite)
+	}
+	root.Time = formatSeconds(total)
+
+	return root
+}
+
+func (r *Report) Write() error {
+	data, err := xml.MarshalIndent(r.Build(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(r.path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(r.path, append([]byte(xml.Header), data...), 0o644)
+}
+
+// AfterAll writes the report when the runner finishes, which happens on
+// RunPackage exit or on cleanup of the test that started the runner. A report
+// that cannot be written is reported on stderr without failing the run.
+func AfterAll(report *Report) axiom.AllHook {
+	return func(_ *axiom.Runner) {
+		if err := report.Write(); err != nil {
+			fmt.Fprintf(os.Stderr, "junit: write report %q: %v\n", report.path, err)
+		}
+	}
+}
+
+func buildTestCase(result CaseResult) TestCase {
+	final := result.Final()
+	testCase := TestCase{
+		Name:      result.Name,
+		ClassName: result.Class,
+		Time:      formatSeconds(result.Duration()),
+		SystemOut: strings.Join(final.Logs, "\n"),
+	}
+	if result.ID != "" {
+		testCase.Properties = &Properties{Items: []Property{{Name: "id", Value: result.ID}}}
+	}
+
+	switch final.Status {
+	case StatusSkipped:
+		testCase.Skipped = &Skipped{Message: final.SkipReason}
+		return testCase
+	case StatusFailed:
+		testCase.Failure = newFailure(final)
+	}
+
+	for _, attempt := range result.Attempts[:len(result.Attempts)-1] {
+		if attempt.Status != StatusFailed {
+			continue
+		}
+
+		failure := newFailure(attempt)
+		entry := RerunEntry{
+			Message:   failure.Message,
+			Type:      failure.Type,
+			Time:      formatSeconds(attempt.Duration),
+			Text:      failure.Text,
+			SystemOut: strings.Join(attempt.Logs, "\n"),
+		}
+		if final.Status == StatusPassed {
+			testCase.FlakyFailures = append(testCase.FlakyFailures, entry)
+		} else {
+			testCase.RerunFailures = append(testCase.RerunFailures, entry)
+		}
+	}
+
+	return testCase
+}
+
+func newFailure(attempt AttemptResult) *Failure {
+	message := fmt.Sprintf("attempt %d failed", attempt.Number)
+	if len(attempt.Failures) > 0 {
+		message = attempt.Failures[0]
+	}
+
+	return &Failure{
+		Message: message,
+		Type:    "failure",
+		Text:    strings.Join(attempt.Failures, "\n"),
+	}
+}
+
+func formatSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
@@ -0,0 +1,142 @@
+package testjunit_test
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testjunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReportConfig(name string, meta axiom.Meta) *axiom.Config {
+	return &axiom.Config{Case: &axiom.Case{ID: name + "-id", Name: name}, Meta: meta}
+}
+
+func TestReportBuild_MapsOutcomesAndReruns(t *testing.T) {
+	report := testjunit.NewReport(testjunit.WithReportName("e2e"))
+	meta := axiom.NewMeta(axiom.WithMetaSuite("users"))
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	flaky := newReportConfig("flaky", meta)
+	report.Record(flaky, testjunit.AttemptResult{Number: 1, Status: testjunit.StatusFailed, Start: start, Duration: time.Second, Failures: []string{"case.panic: reset"}, Logs: []string{"[info] first"}})
+	report.Record(flaky, testjunit.AttemptResult{Number: 2, Status: testjunit.StatusPassed, Start: start, Duration: time.Second})
+
+	broken := newReportConfig("broken", meta)
+	report.Record(broken, testjunit.AttemptResult{Number: 1, Status: testjunit.StatusFailed, Start: start, Duration: time.Second})
+	report.Record(broken, testjunit.AttemptResult{Number: 2, Status: testjunit.StatusFailed, Start: start, Duration: time.Second, Failures: []string{"case.timeout: case timed out after 1s"}})
+
+	skipped := newReportConfig("skipped", axiom.NewMeta(axiom.WithMetaSuite("admin")))
+	report.Record(skipped, testjunit.AttemptResult{Number: 1, Status: testjunit.StatusSkipped, Start: start, SkipReason: "disabled"})
+
+	suites := report.Build()
+
+	assert.Equal(t, "e2e", suites.Name)
+	assert.Equal(t, 3, suites.Tests)
+	assert.Equal(t, 1, suites.Failures)
+	assert.Equal(t, 1, suites.Skipped)
+	assert.Equal(t, "4.000", suites.Time)
+	require.Len(t, suites.Suites, 2)
+
+	admin := suites.Suites[0]
+	assert.Equal(t, "admin", admin.Name)
+	require.Len(t, admin.Cases, 1)
+	assert.Equal(t, "disabled", admin.Cases[0].Skipped.Message)
+
+	users := suites.Suites[1]
+	assert.Equal(t, "users", users.Name)
+	assert.Equal(t, "2026-01-02T03:04:05Z", users.Timestamp)
+	require.Len(t, users.Cases, 2)
+
+	flakyCase := users.Cases[0]
+	assert.Nil(t, flakyCase.Failure)
+	require.Len(t, flakyCase.FlakyFailures, 1)
+	assert.Equal(t, "case.panic: reset", flakyCase.FlakyFailures[0].Message)
+	assert.Equal(t, "[info] first", flakyCase.FlakyFailures[0].SystemOut)
+	assert.Equal(t, "flaky-id", flakyCase.Properties.Items[0].Value)
+
+	brokenCase := users.Cases[1]
+	require.NotNil(t, brokenCase.Failure)
+	assert.Equal(t, "case.timeout: case timed out after 1s", brokenCase.Failure.Message)
+	require.Len(t, brokenCase.RerunFailures, 1)
+	assert.Equal(t, "attempt 1 failed", brokenCase.RerunFailures[0].Message)
+}
+
+func TestReportBuild_FlattensSuiteHierarchy(t *testing.T) {
+	report := testjunit.NewReport()
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	nested := newReportConfig("create admin", axiom.NewMeta(
+		axiom.WithMetaParentSuite("api"),
+		axiom.WithMetaSuite("users"),
+		axiom.WithMetaSubSuite("admins"),
+	))
+	report.Record(nested, testjunit.AttemptResult{Number: 1, Status: testjunit.StatusPassed, Start: start})
+
+	parent := newReportConfig("health", axiom.NewMeta(axiom.WithMetaParentSuite("api")))
+	report.Record(parent, testjunit.AttemptResult{Number: 1, Status: testjunit.StatusPassed, Start: start})
+
+	suites := report.Build()
+
+	require.Len(t, suites.Suites, 2)
+	assert.Equal(t, "api", suites.Suites[0].Name)
+	assert.Equal(t, "api", suites.Suites[0].Cases[0].ClassName)
+	assert.Equal(t, "api / users / admins", suites.Suites[1].Name)
+	assert.Equal(t, 1, suites.Suites[1].Tests)
+	assert.Equal(t, "api.users.admins", suites.Suites[1].Cases[0].ClassName)
+	assert.Equal(t, 2, suites.Tests)
+}
+
+func TestReportWrite_WritesJUnitXML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reports", "junit.xml")
+	report := testjunit.NewReport(testjunit.WithReportPath(path))
+	report.Record(newReportConfig("case", axiom.Meta{}), testjunit.AttemptResult{Number: 1, Status: testjunit.StatusPassed, Start: time.Now()})
+
+	require.NoError(t, report.Write())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), xml.Header)
+
+	var suites testjunit.TestSuites
+	require.NoError(t, xml.Unmarshal(data, &suites))
+	require.Len(t, suites.Suites, 1)
+	assert.Equal(t, "axiom", suites.Suites[0].Name)
+	assert.Equal(t, "case", suites.Suites[0].Cases[0].Name)
+}
+
+func TestReportPathFromEnv_OverridesDefault(t *testing.T) {
+	t.Setenv(testjunit.AxiomJUnitReportPath, filepath.Join(t.TempDir(), "env.xml"))
+
+	report := testjunit.NewReport(testjunit.ReportPathFromEnv())
+	runner := axiom.NewRunner(axiom.WithRunnerHooks(axiom.WithAfterAll(testjunit.AfterAll(report))))
+	runner.ApplyFinish()
+
+	_, err := os.Stat(os.Getenv(testjunit.AxiomJUnitReportPath))
+	assert.NoError(t, err)
+}
+
+func TestAfterAll_ReportsWriteErrorOnStderr(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "\x00", "junit.xml")
+	hook := testjunit.AfterAll(testjunit.NewReport(testjunit.WithReportPath(path)))
+
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	stderr := os.Stderr
+	os.Stderr = writer
+	t.Cleanup(func() { os.Stderr = stderr })
+
+	assert.NotPanics(t, func() { hook(&axiom.Runner{}) })
+	os.Stderr = stderr
+	require.NoError(t, writer.Close())
+
+	output, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "junit: write report")
+	assert.Contains(t, string(output), "junit.xml")
+}
@@ -0,0 +1,16 @@
+package testlogger
+
+import (
+	"log/slog"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+func MapAttrs(attrs []axiom.Attr) []slog.Attr {
+	result := make([]slog.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		result = append(result, slog.Any(attr.Key, attr.Value))
+	}
+
+	return result
+}
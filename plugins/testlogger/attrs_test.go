@@ -0,0 +1,23 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testlogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapAttrs(t *testing.T) {
+	attrs := testlogger.MapAttrs([]axiom.Attr{
+		axiom.NewAttr("user_id", 42),
+		axiom.NewAttr("region", "eu"),
+	})
+
+	assert.Equal(t, []slog.Attr{slog.Int("user_id", 42), slog.String("region", "eu")}, attrs)
+}
+
+func TestMapAttrs_Empty(t *testing.T) {
+	assert.Empty(t, testlogger.MapAttrs(nil))
+}
@@ -0,0 +1,112 @@
+package testlogger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	AxiomLogDir    = "AXIOM_LOG_DIR"
+	AxiomLogLevel  = "AXIOM_LOG_LEVEL"
+	AxiomLogFormat = "AXIOM_LOG_FORMAT"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+type Config struct {
+	Dir      string
+	Level    slog.Level
+	Format   string
+	Output   io.Writer
+	Handler  slog.Handler
+	TestingT bool
+
+	files *caseFiles
+}
+
+type ConfigOption func(*Config)
+
+func NewConfig(opts ...ConfigOption) Config {
+	c := Config{Level: slog.LevelDebug, Format: FormatText}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WithConfigHandler sends logs to a custom handler. Level, format and output
+// options are ignored in that case.
+func WithConfigHandler(handler slog.Handler) ConfigOption {
+	return func(c *Config) { c.Handler = handler }
+}
+
+func WithConfigLevel(level slog.Level) ConfigOption {
+	return func(c *Config) { c.Level = level }
+}
+
+func WithConfigFormat(format string) ConfigOption {
+	return func(c *Config) { c.Format = ParseFormat(format) }
+}
+
+func WithConfigJSON() ConfigOption {
+	return WithConfigFormat(FormatJSON)
+}
+
+func WithConfigOutput(w io.Writer) ConfigOption {
+	return func(c *Config) { c.Output = w }
+}
+
+// WithConfigTestingT writes logs through testing.T.Log of the running attempt,
+// so output is grouped with the right subtest.
+func WithConfigTestingT() ConfigOption {
+	return func(c *Config) { c.TestingT = true }
+}
+
+// WithConfigDir writes logs of each case to its own file under dir.
+func WithConfigDir(dir string) ConfigOption {
+	return func(c *Config) { c.Dir = dir }
+}
+
+func ConfigFromEnv() ConfigOption {
+	return func(c *Config) {
+		if value := os.Getenv(AxiomLogLevel); value != "" {
+			c.Level = ParseLevel(value)
+		}
+		if value := os.Getenv(AxiomLogFormat); value != "" {
+			c.Format = ParseFormat(value)
+		}
+		if value := os.Getenv(AxiomLogDir); value != "" {
+			c.Dir = value
+		}
+	}
+}
+
+func ParseLevel(s string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug
+	case "info":
+		return slog.LevelInfo
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error", "fatal":
+		return slog.LevelError
+	default:
+		panic(fmt.Sprintf("testlogger: unknown log level %q", s))
+	}
+}
+
+func ParseFormat(s string) string {
+	switch format := strings.ToLower(strings.TrimSpace(s)); format {
+	case FormatText, FormatJSON:
+		return format
+	default:
+		panic(fmt.Sprintf("testlogger: unknown log format %q", s))
+	}
+}
@@ -0,0 +1,56 @@
+package testlogger_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom/plugins/testlogger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewConfig_Defaults(t *testing.T) {
+	cfg := testlogger.NewConfig()
+
+	assert.Equal(t, slog.LevelDebug, cfg.Level)
+	assert.Equal(t, testlogger.FormatText, cfg.Format)
+	assert.Nil(t, cfg.Output)
+	assert.Nil(t, cfg.Handler)
+	assert.False(t, cfg.TestingT)
+	assert.Empty(t, cfg.Dir)
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv(testlogger.AxiomLogLevel, "WARNING")
+	t.Setenv(testlogger.AxiomLogFormat, " json ")
+	t.Setenv(testlogger.AxiomLogDir, "logs")
+
+	cfg := testlogger.NewConfig(testlogger.ConfigFromEnv())
+
+	assert.Equal(t, slog.LevelWarn, cfg.Level)
+	assert.Equal(t, testlogger.FormatJSON, cfg.Format)
+	assert.Equal(t, "logs", cfg.Dir)
+}
+
+func TestConfigFromEnv_KeepsCodeDefaultsWhenUnset(t *testing.T) {
+	t.Setenv(testlogger.AxiomLogLevel, "")
+
+	cfg := testlogger.NewConfig(
+		testlogger.WithConfigLevel(slog.LevelError),
+		testlogger.ConfigFromEnv(),
+	)
+
+	assert.Equal(t, slog.LevelError, cfg.Level)
+}
+
+func TestParseLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelDebug, testlogger.ParseLevel("debug"))
+	assert.Equal(t, slog.LevelInfo, testlogger.ParseLevel("Info"))
+	assert.Equal(t, slog.LevelWarn, testlogger.ParseLevel("warn"))
+	assert.Equal(t, slog.LevelError, testlogger.ParseLevel("fatal"))
+	assert.PanicsWithValue(t, `testlogger: unknown log level "loud"`, func() { testlogger.ParseLevel("loud") })
+}
+
+func TestParseFormat(t *testing.T) {
+	assert.Equal(t, testlogger.FormatJSON, testlogger.ParseFormat("JSON"))
+	assert.PanicsWithValue(t, `testlogger: unknown log format "xml"`, func() { testlogger.ParseFormat("xml") })
+}
@@ -1,26 +1,49 @@
 package testlogger
 
 import (
+	"io"
 	"log/slog"
 	"os"
 
 	"github.com/Nikita-Filonov/axiom"
 )
 
-func Plugin() axiom.Plugin {
+func Plugin(options ...ConfigOption) axiom.Plugin {
+	config := NewConfig(options...)
+	config.files = newCaseFiles()
+
 	return func(cfg *axiom.Config) {
-		logger := slog.New(
-			slog.NewTextHandler(
-				os.Stdout,
-				&slog.HandlerOptions{
-					Level: slog.LevelDebug,
-				},
-			),
-		)
+		logger := slog.New(config.NewHandler(cfg))
 
 		cfg.Runtime.EmitLogSink(func(l axiom.Log) {
 			level := MapLevel(l.Level)
-			logger.Log(cfg.Context.Raw, level, l.Text)
+			logger.LogAttrs(cfg.Context.Raw, level, l.Text, MapAttrs(l.Attrs)...)
 		})
 	}
 }
+
+func (c Config) NewHandler(cfg *axiom.Config) slog.Handler {
+	if c.Handler != nil {
+		return c.Handler
+	}
+
+	options := &slog.HandlerOptions{Level: c.Level}
+	if c.Format == FormatJSON {
+		return slog.NewJSONHandler(c.writer(cfg), options)
+	}
+
+	return slog.NewTextHandler(c.writer(cfg), options)
+}
+
+func (c Config) writer(cfg *axiom.Config) io.Writer {
+	switch {
+	case c.Dir != "":
+		return newCaseFileWriter(c.Dir, cfg, c.files)
+	case c.TestingT:
+		return testingWriter{cfg: cfg}
+	case c.Output != nil:
+		return c.Output
+	default:
+		return os.Stdout
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -106,3 +107,132 @@ func TestPlugin_LogLevels(t *testing.T) {
 		assert.Contains(t, out.String(), tt.log.Text)
 	}
 }
+
+func TestPlugin_ForwardsAttrsAndCaseContext(t *testing.T) {
+	var output bytes.Buffer
+
+	withStdout(&output, func() {
+		cfg := &axiom.Config{
+			Case:    &axiom.Case{ID: "AX-1", Name: "create user"},
+			Attempt: 2,
+			Context: axiom.Context{Raw: context.Background()},
+			Runtime: axiom.NewRuntime(),
+		}
+
+		plugin := testlogger.Plugin()
+		plugin(cfg)
+
+		cfg.Step("create", func() {
+			cfg.Log(axiom.NewLog(
+				axiom.WithLogLevel(axiom.LogLevelInfo),
+				axiom.WithLogText("created"),
+				axiom.WithLogAttr("user_id", 42),
+			))
+		})
+	})
+
+	text := output.String()
+
+	assert.Contains(t, text, "msg=created")
+	assert.Contains(t, text, "case_id=AX-1")
+	assert.Contains(t, text, `case_name="create user"`)
+	assert.Contains(t, text, "attempt=2")
+	assert.Contains(t, text, "step=create")
+	assert.Contains(t, text, "user_id=42")
+}
+
+func TestPlugin_CustomHandler(t *testing.T) {
+	handler := &testHandler{}
+	cfg := &axiom.Config{
+		Context: axiom.Context{Raw: context.Background()},
+		Runtime: axiom.NewRuntime(),
+	}
+
+	testlogger.Plugin(testlogger.WithConfigHandler(handler))(cfg)
+	cfg.Log(axiom.NewErrorLog("boom"))
+
+	assert.Equal(t, []record{{level: slog.LevelError, msg: "boom"}}, handler.records)
+}
+
+func TestPlugin_JSONOutputWithMinimumLevel(t *testing.T) {
+	var output bytes.Buffer
+	cfg := &axiom.Config{
+		Context: axiom.Context{Raw: context.Background()},
+		Runtime: axiom.NewRuntime(),
+	}
+
+	testlogger.Plugin(
+		testlogger.WithConfigJSON(),
+		testlogger.WithConfigLevel(slog.LevelInfo),
+		testlogger.WithConfigOutput(&output),
+	)(cfg)
+	cfg.Log(axiom.NewDebugLog("hidden"))
+	cfg.Log(axiom.NewInfoLog("visible"))
+
+	assert.NotContains(t, output.String(), "hidden")
+	assert.Contains(t, output.String(), `"msg":"visible"`)
+	assert.Contains(t, output.String(), `"level":"INFO"`)
+}
+
+func TestPlugin_TestingTWithoutTestDoesNotPanic(t *testing.T) {
+	cfg := &axiom.Config{
+		Context: axiom.Context{Raw: context.Background()},
+		Runtime: axiom.NewRuntime(),
+	}
+
+	testlogger.Plugin(testlogger.WithConfigTestingT())(cfg)
+
+	assert.NotPanics(t, func() { cfg.Log(axiom.NewInfoLog("nowhere")) })
+}
+
+func TestPlugin_WritesPerCaseFiles(t *testing.T) {
+	dir := t.TempDir()
+	runner := axiom.NewRunner(
+		axiom.WithRunnerPlugins(testlogger.Plugin(testlogger.WithConfigDir(dir))),
+	)
+
+	for _, name := range []string{"create user", "delete user"} {
+		runner.RunCase(t, axiom.NewCase(axiom.WithCaseName(name)), func(cfg *axiom.Config) {
+			cfg.Log(axiom.NewInfoLog("running " + name))
+		})
+	}
+
+	created, err := os.ReadFile(filepath.Join(dir, "TestPlugin_WritesPerCaseFiles", "create_user.log"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(created), `msg="running create user"`)
+	assert.NotContains(t, string(created), "delete user")
+
+	deleted, err := os.ReadFile(filepath.Join(dir, "TestPlugin_WritesPerCaseFiles", "delete_user.log"))
+	assert.NoError(t, err)
+	assert.Contains(t, string(deleted), `msg="running delete user"`)
+}
+
+func TestPlugin_PerCaseFilesStartOverOnEveryRun(t *testing.T) {
+	dir := t.TempDir()
+
+	for _, run := range []string{"first", "second"} {
+		runner := axiom.NewRunner(
+			axiom.WithRunnerPlugins(testlogger.Plugin(testlogger.WithConfigDir(dir))),
+		)
+
+		runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("create user")), func(cfg *axiom.Config) {
+			cfg.Log(axiom.NewInfoLog(run + " run case"))
+		})
+		runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("create user")), func(cfg *axiom.Config) {
+			cfg.Log(axiom.NewInfoLog(run + " run repeated case"))
+		})
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "TestPlugin_PerCaseFilesStartOverOnEveryRun", "create_user.log"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "first run")
+	assert.Contains(t, string(data), `msg="second run case"`)
+	assert.Contains(t, string(data), `msg="second run repeated case"`)
+}
+
+func TestCaseLogPath(t *testing.T) {
+	cfg := &axiom.Config{RootT: t, Case: &axiom.Case{Name: "GET /users/{id} ok?"}}
+
+	assert.Equal(t, filepath.Join("logs", "TestCaseLogPath", "GET_users_id_ok.log"), testlogger.CaseLogPath("logs", cfg))
+	assert.Equal(t, filepath.Join("logs", "case.log"), testlogger.CaseLogPath("logs", &axiom.Config{}))
+}
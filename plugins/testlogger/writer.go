@@ -0,0 +1,127 @@
+package testlogger
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+var unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+type testingWriter struct {
+	cfg *axiom.Config
+}
+
+func (w testingWriter) Write(p []byte) (int, error) {
+	if t := w.cfg.T(); t != nil {
+		t.Log(strings.TrimRight(string(p), "\n"))
+	}
+
+	return len(p), nil
+}
+
+// caseFiles remembers which case files a runner has opened, so the first
+// attempt truncates the file left by a previous run and later attempts append.
+type caseFiles struct {
+	mu     sync.Mutex
+	opened map[string]bool
+}
+
+func newCaseFiles() *caseFiles {
+	return &caseFiles{opened: map[string]bool{}}
+}
+
+func (f *caseFiles) openFlag(path string) int {
+	if f == nil {
+		return os.O_APPEND
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.opened[path] {
+		return os.O_APPEND
+	}
+	f.opened[path] = true
+
+	return os.O_TRUNC
+}
+
+// caseFileWriter opens the case log file on the first write and closes it
+// when the running attempt finishes. Attempts of the same case append.
+type caseFileWriter struct {
+	mu    sync.Mutex
+	cfg   *axiom.Config
+	path  string
+	file  *os.File
+	files *caseFiles
+}
+
+func newCaseFileWriter(dir string, cfg *axiom.Config, files *caseFiles) *caseFileWriter {
+	return &caseFileWriter{cfg: cfg, path: CaseLogPath(dir, cfg), files: files}
+}
+
+func (w *caseFileWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file == nil {
+		if err := os.MkdirAll(filepath.Dir(w.path), 0o755); err != nil {
+			return 0, err
+		}
+
+		file, err := os.OpenFile(w.path, os.O_CREATE|os.O_WRONLY|w.files.openFlag(w.path), 0o644)
+		if err != nil {
+			return 0, err
+		}
+		w.file = file
+
+		if t := w.cfg.T(); t != nil {
+			t.Cleanup(w.close)
+		}
+	}
+
+	return w.file.Write(p)
+}
+
+func (w *caseFileWriter) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.file != nil {
+		_ = w.file.Close()
+		w.file = nil
+	}
+}
+
+// CaseLogPath returns <dir>/<root test>/<case>.log with every segment reduced
+// to characters that are safe in file names.
+func CaseLogPath(dir string, cfg *axiom.Config) string {
+	name := "case"
+	if cfg.Case != nil && cfg.Case.Name != "" {
+		name = cfg.Case.Name
+	}
+
+	segments := []string{dir}
+	if cfg.RootT != nil {
+		for _, segment := range strings.Split(cfg.RootT.Name(), "/") {
+			segments = append(segments, sanitizePathSegment(segment))
+		}
+	}
+	segments = append(segments, sanitizePathSegment(name)+".log")
+
+	return filepath.Join(segments...)
+}
+
+func sanitizePathSegment(s string) string {
+	s = strings.Trim(unsafePathChars.ReplaceAllString(s, "_"), "_")
+	if s == "" || s == "." || s == ".." {
+		return "_"
+	}
+
+	return s
+}
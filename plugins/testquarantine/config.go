@@ -0,0 +1,88 @@
+package testquarantine
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	AxiomQuarantine     = "AXIOM_QUARANTINE"
+	AxiomQuarantineFile = "AXIOM_QUARANTINE_FILE"
+)
+
+type Config struct {
+	Entries map[string]string
+}
+
+type ConfigOption func(*Config)
+
+func NewConfig(opts ...ConfigOption) Config {
+	c := Config{Entries: map[string]string{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+func WithConfigIDs(ids ...string) ConfigOption {
+	return func(c *Config) {
+		for _, id := range ids {
+			if id = strings.TrimSpace(id); id != "" {
+				c.Entries[id] = ""
+			}
+		}
+	}
+}
+
+func WithConfigEntry(id, reason string) ConfigOption {
+	return func(c *Config) {
+		if id = strings.TrimSpace(id); id != "" {
+			c.Entries[id] = strings.TrimSpace(reason)
+		}
+	}
+}
+
+// ConfigFromFile reads one case ID per line. Empty lines and lines starting
+// with "#" are ignored; text after " #" on an ID line becomes the reason.
+func ConfigFromFile(path string) ConfigOption {
+	return func(c *Config) {
+		file, err := os.Open(path)
+		if err != nil {
+			panic(fmt.Sprintf("testquarantine: open %q: %v", path, err))
+		}
+		defer func() { _ = file.Close() }()
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			id, reason := ParseLine(scanner.Text())
+			if id != "" {
+				c.Entries[id] = reason
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			panic(fmt.Sprintf("testquarantine: read %q: %v", path, err))
+		}
+	}
+}
+
+func ConfigFromEnv() ConfigOption {
+	return func(c *Config) {
+		WithConfigIDs(strings.Split(os.Getenv(AxiomQuarantine), ",")...)(c)
+
+		if path := os.Getenv(AxiomQuarantineFile); path != "" {
+			ConfigFromFile(path)(c)
+		}
+	}
+}
+
+func ParseLine(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return "", ""
+	}
+
+	id, reason, _ := strings.Cut(line, " #")
+	return strings.TrimSpace(id), strings.TrimSpace(reason)
+}
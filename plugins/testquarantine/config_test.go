@@ -0,0 +1,48 @@
+package testquarantine_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom/plugins/testquarantine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLine(t *testing.T) {
+	cases := map[string][2]string{
+		"":                        {"", ""},
+		"   ":                     {"", ""},
+		"# comment":               {"", ""},
+		"AX-1":                    {"AX-1", ""},
+		"  AX-2  # flaky on CI  ": {"AX-2", "flaky on CI"},
+	}
+
+	for line, expected := range cases {
+		id, reason := testquarantine.ParseLine(line)
+		assert.Equal(t, expected[0], id, line)
+		assert.Equal(t, expected[1], reason, line)
+	}
+}
+
+func TestConfigFromEnv_ReadsListAndFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quarantine.txt")
+	assert.NoError(t, os.WriteFile(path, []byte("# quarantined cases\nAX-3 # payments sandbox\n"), 0o644))
+
+	t.Setenv(testquarantine.AxiomQuarantine, "AX-1, AX-2,")
+	t.Setenv(testquarantine.AxiomQuarantineFile, path)
+
+	cfg := testquarantine.NewConfig(testquarantine.ConfigFromEnv())
+
+	assert.Equal(t, map[string]string{
+		"AX-1": "",
+		"AX-2": "",
+		"AX-3": "payments sandbox",
+	}, cfg.Entries)
+}
+
+func TestConfigFromFile_PanicsOnMissingFile(t *testing.T) {
+	assert.Panics(t, func() {
+		testquarantine.NewConfig(testquarantine.ConfigFromFile(filepath.Join(t.TempDir(), "missing.txt")))
+	})
+}
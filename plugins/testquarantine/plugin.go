@@ -0,0 +1,39 @@
+package testquarantine
+
+import (
+	"github.com/Nikita-Filonov/axiom"
+)
+
+func Plugin(options ...ConfigOption) axiom.Plugin {
+	cfg := NewConfig(options...)
+
+	return func(c *axiom.Config) {
+		id, ok := cfg.Match(c)
+		if !ok {
+			return
+		}
+
+		reason := cfg.Entries[id]
+		if reason == "" {
+			reason = "quarantined: " + id
+		}
+
+		c.Quarantine = c.Quarantine.Join(axiom.NewQuarantine(axiom.QuarantineBecause(reason)))
+	}
+}
+
+func (c Config) Match(cfg *axiom.Config) (string, bool) {
+	if cfg.Case != nil && cfg.Case.ID != "" {
+		if _, ok := c.Entries[cfg.Case.ID]; ok {
+			return cfg.Case.ID, true
+		}
+	}
+
+	for _, testCase := range cfg.Meta.TestCases {
+		if _, ok := c.Entries[testCase]; ok {
+			return testCase, true
+		}
+	}
+
+	return "", false
+}
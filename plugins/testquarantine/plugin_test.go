@@ -0,0 +1,67 @@
+package testquarantine_test
+
+import (
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testquarantine"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlugin_QuarantinesByCaseID(t *testing.T) {
+	cfg := &axiom.Config{Case: &axiom.Case{ID: "AX-1"}}
+
+	testquarantine.Plugin(testquarantine.WithConfigIDs("AX-1"))(cfg)
+
+	assert.True(t, cfg.Quarantine.Enabled)
+	assert.Equal(t, "quarantined: AX-1", cfg.Quarantine.Reason)
+}
+
+func TestPlugin_QuarantinesByMetaTestCase(t *testing.T) {
+	cfg := &axiom.Config{
+		Case: &axiom.Case{ID: "other"},
+		Meta: axiom.NewMeta(axiom.WithMetaTestCase("TMS-7")),
+	}
+
+	testquarantine.Plugin(testquarantine.WithConfigEntry("TMS-7", "sandbox outage"))(cfg)
+
+	assert.True(t, cfg.Quarantine.Enabled)
+	assert.Equal(t, "sandbox outage", cfg.Quarantine.Reason)
+}
+
+func TestPlugin_LeavesOtherCasesUntouched(t *testing.T) {
+	cfg := &axiom.Config{Case: &axiom.Case{ID: "AX-2"}}
+
+	testquarantine.Plugin(testquarantine.WithConfigIDs("AX-1"))(cfg)
+
+	assert.False(t, cfg.Quarantine.Enabled)
+}
+
+func TestPlugin_QuarantinedCaseRunsAndDoesNotFail(t *testing.T) {
+	var events []axiom.Event
+	var ran bool
+	var failures []string
+
+	runner := axiom.NewRunner(
+		axiom.WithRunnerPlugins(testquarantine.Plugin(testquarantine.WithConfigIDs("AX-1"))),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeCaseQuarantined {
+				events = append(events, e)
+			}
+		})),
+		axiom.WithRunnerHooks(axiom.WithAfterTest(func(cfg *axiom.Config) {
+			failures = cfg.QuarantinedFailures()
+		})),
+	)
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseID("AX-1"), axiom.WithCaseName("flaky")), func(cfg *axiom.Config) {
+		ran = true
+		cfg.Step("unstable", func() { panic("connection reset") })
+	})
+
+	assert.True(t, ran)
+	assert.Equal(t, []string{`panic in step "unstable": connection reset`}, failures)
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, "quarantined: AX-1", events[0].Name)
+	}
+}
@@ -0,0 +1,97 @@
+package testshard
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom/plugins/teststats"
+)
+
+const (
+	AxiomShardIndex   = "AXIOM_SHARD_INDEX"
+	AxiomShardTotal   = "AXIOM_SHARD_TOTAL"
+	AxiomShardTimings = "AXIOM_SHARD_TIMINGS"
+)
+
+type Config struct {
+	Index   int
+	Total   int
+	Timings map[string]time.Duration
+}
+
+type ConfigOption func(*Config)
+
+func NewConfig(opts ...ConfigOption) Config {
+	c := Config{Timings: map[string]time.Duration{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+func WithConfigShard(index, total int) ConfigOption {
+	return func(c *Config) {
+		c.Index = index
+		c.Total = total
+	}
+}
+
+func WithConfigTimings(timings ...teststats.Timing) ConfigOption {
+	return func(c *Config) {
+		for _, timing := range timings {
+			c.Timings[timingKey(timing.ID, timing.Name)] += timing.Duration
+		}
+	}
+}
+
+func WithConfigTimingsFile(paths ...string) ConfigOption {
+	return func(c *Config) {
+		for _, path := range paths {
+			if path = strings.TrimSpace(path); path == "" {
+				continue
+			}
+
+			timings, err := teststats.ReadTimings(path)
+			if err != nil {
+				panic(fmt.Sprintf("testshard: read timings %q: %v", path, err))
+			}
+			WithConfigTimings(timings...)(c)
+		}
+	}
+}
+
+func ConfigFromEnv() ConfigOption {
+	return func(c *Config) {
+		if value := os.Getenv(AxiomShardIndex); value != "" {
+			c.Index = parseInt(AxiomShardIndex, value)
+		}
+		if value := os.Getenv(AxiomShardTotal); value != "" {
+			c.Total = parseInt(AxiomShardTotal, value)
+		}
+
+		WithConfigTimingsFile(strings.Split(os.Getenv(AxiomShardTimings), ",")...)(c)
+	}
+}
+
+func (c Config) Validate() error {
+	if c.Total < 0 {
+		return fmt.Errorf("shard total must not be negative, got %d", c.Total)
+	}
+	if c.Total > 0 && (c.Index < 0 || c.Index >= c.Total) {
+		return fmt.Errorf("shard index must be in [0, %d), got %d", c.Total, c.Index)
+	}
+
+	return nil
+}
+
+func parseInt(name, value string) int {
+	n, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		panic(fmt.Sprintf("testshard: invalid %s %q: %v", name, value, err))
+	}
+
+	return n
+}
@@ -0,0 +1,78 @@
+package testshard_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testshard"
+	"github.com/Nikita-Filonov/axiom/plugins/teststats"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	dir := t.TempDir()
+	first := filepath.Join(dir, "shard-0.json")
+	second := filepath.Join(dir, "shard-1.json")
+	assert.NoError(t, os.WriteFile(first, []byte(`[{"id":"AX-1","name":"a","duration":1000}]`), 0o644))
+	assert.NoError(t, os.WriteFile(second, []byte(`[{"name":"b","duration":2000}]`), 0o644))
+
+	t.Setenv(testshard.AxiomShardIndex, "1")
+	t.Setenv(testshard.AxiomShardTotal, "3")
+	t.Setenv(testshard.AxiomShardTimings, first+","+second)
+
+	cfg := testshard.NewConfig(testshard.ConfigFromEnv())
+
+	assert.Equal(t, 1, cfg.Index)
+	assert.Equal(t, 3, cfg.Total)
+	assert.Equal(t, map[string]time.Duration{"AX-1": 1000, "b": 2000}, cfg.Timings)
+}
+
+func TestConfigFromEnv_PanicsOnInvalidNumber(t *testing.T) {
+	t.Setenv(testshard.AxiomShardTotal, "two")
+
+	assert.Panics(t, func() { testshard.NewConfig(testshard.ConfigFromEnv()) })
+}
+
+func TestWithConfigTimingsFile_PanicsOnMissingFile(t *testing.T) {
+	assert.Panics(t, func() {
+		testshard.NewConfig(testshard.WithConfigTimingsFile(filepath.Join(t.TempDir(), "missing.json")))
+	})
+}
+
+func TestWithConfigTimingsFile_ReadsTeststatsTimings(t *testing.T) {
+	stats := teststats.NewStats()
+	path := filepath.Join(t.TempDir(), "timings", "shard-0.json")
+	runner := axiom.NewRunner(
+		axiom.WithRunnerPlugins(teststats.Plugin(stats)),
+		axiom.WithRunnerHooks(axiom.WithAfterAll(teststats.WriteTimings(stats, path))),
+	)
+
+	t.Run("previous run", func(t *testing.T) {
+		runner.RunCase(t, axiom.NewCase(axiom.WithCaseID("AX-1"), axiom.WithCaseName("create user")), func(cfg *axiom.Config) {
+			time.Sleep(2 * time.Millisecond)
+		})
+		runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("delete user")), func(cfg *axiom.Config) {})
+	})
+	runner.ApplyFinish()
+
+	cfg := testshard.NewConfig(testshard.WithConfigTimingsFile(path))
+
+	timings := stats.Timings()
+	assert.Len(t, timings, 2)
+	assert.Equal(t, map[string]time.Duration{
+		"AX-1":        timings[0].Duration,
+		"delete user": timings[1].Duration,
+	}, cfg.Timings)
+	assert.GreaterOrEqual(t, cfg.Timings["AX-1"], 2*time.Millisecond)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, testshard.NewConfig().Validate())
+	assert.NoError(t, testshard.NewConfig(testshard.WithConfigShard(2, 3)).Validate())
+	assert.Error(t, testshard.NewConfig(testshard.WithConfigShard(3, 3)).Validate())
+	assert.Error(t, testshard.NewConfig(testshard.WithConfigShard(-1, 3)).Validate())
+	assert.Error(t, testshard.NewConfig(testshard.WithConfigShard(0, -1)).Validate())
+}
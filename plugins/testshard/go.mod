@@ -0,0 +1,18 @@
+module github.com/Nikita-Filonov/axiom/plugins/testshard
+
+go 1.25.5
+
+require (
+	github.com/Nikita-Filonov/axiom v1.7.0
+	github.com/Nikita-Filonov/axiom/plugins/teststats v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.11.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+// Timings files are read with teststats, which lives in this repository.
+replace github.com/Nikita-Filonov/axiom/plugins/teststats => ../teststats
@@ -0,0 +1,31 @@
+package testshard
+
+import (
+	"fmt"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+func Plugin(options ...ConfigOption) axiom.Plugin {
+	cfg := NewConfig(options...)
+	if err := cfg.Validate(); err != nil {
+		panic(fmt.Sprintf("testshard: %v", err))
+	}
+
+	sharder := NewSharder(cfg.Total, cfg.Timings)
+
+	return func(c *axiom.Config) {
+		if cfg.Total <= 1 || c.Case == nil {
+			return
+		}
+
+		shard := sharder.Shard(timingKey(c.Case.ID, c.Case.Name))
+		if shard == cfg.Index {
+			return
+		}
+
+		c.Skip = axiom.NewSkip(axiom.SkipBecause(
+			fmt.Sprintf("belongs to shard %d of %d, running shard %d", shard, cfg.Total, cfg.Index),
+		))
+	}
+}
@@ -0,0 +1,53 @@
+package testshard_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testshard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlugin_EachCaseRunsOnExactlyOneShard(t *testing.T) {
+	const total = 3
+
+	for i := 0; i < 20; i++ {
+		c := &axiom.Case{ID: fmt.Sprintf("AX-%d", i)}
+
+		running := 0
+		for index := 0; index < total; index++ {
+			cfg := &axiom.Config{Case: c}
+			testshard.Plugin(testshard.WithConfigShard(index, total))(cfg)
+
+			if !cfg.Skip.Enabled {
+				running++
+			}
+		}
+
+		assert.Equal(t, 1, running, c.ID)
+	}
+}
+
+func TestPlugin_SkipReasonNamesShard(t *testing.T) {
+	c := &axiom.Case{Name: "create user"}
+	shard := testshard.HashShard("create user", 2)
+
+	cfg := &axiom.Config{Case: c}
+	testshard.Plugin(testshard.WithConfigShard(1-shard, 2))(cfg)
+
+	assert.True(t, cfg.Skip.Enabled)
+	assert.Equal(t, fmt.Sprintf("belongs to shard %d of 2, running shard %d", shard, 1-shard), cfg.Skip.Reason)
+}
+
+func TestPlugin_NoShardingByDefault(t *testing.T) {
+	cfg := &axiom.Config{Case: &axiom.Case{Name: "create user"}}
+
+	testshard.Plugin()(cfg)
+
+	assert.False(t, cfg.Skip.Enabled)
+}
+
+func TestPlugin_PanicsOnInvalidShard(t *testing.T) {
+	assert.Panics(t, func() { testshard.Plugin(testshard.WithConfigShard(2, 2)) })
+}
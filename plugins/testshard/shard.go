@@ -0,0 +1,75 @@
+package testshard
+
+import (
+	"hash/fnv"
+	"sort"
+	"time"
+)
+
+type Sharder struct {
+	total    int
+	assigned map[string]int
+}
+
+// NewSharder assigns every case with a known duration to the least loaded
+// shard, longest first. Cases without timings fall back to hashing, so all
+// machines agree on the assignment as long as they share the timings.
+func NewSharder(total int, timings map[string]time.Duration) *Sharder {
+	s := &Sharder{total: total, assigned: map[string]int{}}
+	if total <= 0 || len(timings) == 0 {
+		return s
+	}
+
+	keys := make([]string, 0, len(timings))
+	for key := range timings {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if timings[keys[i]] != timings[keys[j]] {
+			return timings[keys[i]] > timings[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	loads := make([]time.Duration, total)
+	for _, key := range keys {
+		shard := 0
+		for i := 1; i < total; i++ {
+			if loads[i] < loads[shard] {
+				shard = i
+			}
+		}
+
+		s.assigned[key] = shard
+		loads[shard] += timings[key]
+	}
+
+	return s
+}
+
+func (s *Sharder) Shard(key string) int {
+	if shard, ok := s.assigned[key]; ok {
+		return shard
+	}
+
+	return HashShard(key, s.total)
+}
+
+func HashShard(key string, total int) int {
+	if total <= 1 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+
+	return int(h.Sum32() % uint32(total))
+}
+
+func timingKey(id, name string) string {
+	if id != "" {
+		return id
+	}
+
+	return name
+}
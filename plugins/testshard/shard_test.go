@@ -0,0 +1,46 @@
+package testshard_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom/plugins/testshard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashShard_IsDeterministicAndInRange(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		key := fmt.Sprintf("case-%d", i)
+		shard := testshard.HashShard(key, 4)
+
+		assert.GreaterOrEqual(t, shard, 0)
+		assert.Less(t, shard, 4)
+		assert.Equal(t, shard, testshard.HashShard(key, 4))
+	}
+}
+
+func TestHashShard_SingleShard(t *testing.T) {
+	assert.Equal(t, 0, testshard.HashShard("anything", 1))
+	assert.Equal(t, 0, testshard.HashShard("anything", 0))
+}
+
+func TestSharder_BalancesByDuration(t *testing.T) {
+	sharder := testshard.NewSharder(2, map[string]time.Duration{
+		"a": 10 * time.Second,
+		"b": 6 * time.Second,
+		"c": 5 * time.Second,
+		"d": 1 * time.Second,
+	})
+
+	assert.Equal(t, 0, sharder.Shard("a"))
+	assert.Equal(t, 1, sharder.Shard("b"))
+	assert.Equal(t, 1, sharder.Shard("c"))
+	assert.Equal(t, 0, sharder.Shard("d"))
+}
+
+func TestSharder_FallsBackToHashForUnknownCases(t *testing.T) {
+	sharder := testshard.NewSharder(3, map[string]time.Duration{"a": time.Second})
+
+	assert.Equal(t, testshard.HashShard("new case", 3), sharder.Shard("new case"))
+}
@@ -11,18 +11,21 @@ const (
 	StatusFailed  = "failed"
 	StatusSkipped = "skipped"
 	StatusFlaky   = "flaky"
+
+	StatusQuarantined = "quarantined"
 )
 
 type CaseResult struct {
-	ID       string
-	Name     string
-	Attempts int
-	Duration time.Duration
-	Status   string
-	Error    error
-	Start    time.Time
-	End      time.Time
-	Meta     axiom.Meta
+	ID          string
+	Name        string
+	Attempts    int
+	Duration    time.Duration
+	Status      string
+	Error       error
+	Start       time.Time
+	End         time.Time
+	Meta        axiom.Meta
+	Quarantined bool
 }
 
 func NewCaseResult(cfg *axiom.Config) *CaseResult {
@@ -33,25 +36,3 @@ func NewCaseResult(cfg *axiom.Config) *CaseResult {
 		Start: time.Now(),
 	}
 }
-
-func (r *CaseResult) Finalize(cfg *axiom.Config, attempts int) {
-	r.Attempts = attempts
-	r.End = time.Now()
-	r.Duration = r.End.Sub(r.Start)
-
-	if cfg.Skip.Enabled {
-		r.Status = StatusSkipped
-		return
-	}
-
-	if !cfg.SubT.Failed() {
-		if attempts > 1 {
-			r.Status = StatusFlaky
-		} else {
-			r.Status = StatusPassed
-		}
-		return
-	}
-
-	r.Status = StatusFailed
-}
@@ -2,57 +2,12 @@ package teststats_test
 
 import (
 	"testing"
-	"time"
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/Nikita-Filonov/axiom/plugins/teststats"
 	"github.com/stretchr/testify/assert"
 )
 
-func TestCaseResult_Finalize_Skipped(t *testing.T) {
-	cfg := &axiom.Config{
-		Skip: axiom.Skip{Enabled: true},
-		Case: &axiom.Case{ID: "1", Name: "TestSkip"},
-	}
-
-	cr := teststats.NewCaseResult(cfg)
-
-	cr.Finalize(cfg, 1)
-
-	assert.Equal(t, teststats.StatusSkipped, cr.Status)
-	assert.Equal(t, 1, cr.Attempts)
-	assert.Greater(t, cr.Duration, time.Duration(0))
-}
-
-func TestCaseResult_Finalize_Passed_SingleAttempt(t *testing.T) {
-	cfg := &axiom.Config{
-		SubT: t,
-		Skip: axiom.Skip{},
-		Case: &axiom.Case{ID: "2", Name: "TestPass"},
-	}
-
-	cr := teststats.NewCaseResult(cfg)
-
-	cr.Finalize(cfg, 1)
-
-	assert.Equal(t, teststats.StatusPassed, cr.Status)
-	assert.Equal(t, 1, cr.Attempts)
-}
-
-func TestCaseResult_Finalize_Flaky_MultipleAttempts(t *testing.T) {
-	cfg := &axiom.Config{
-		SubT: t,
-		Case: &axiom.Case{ID: "3", Name: "TestFlaky"},
-	}
-
-	cr := teststats.NewCaseResult(cfg)
-
-	cr.Finalize(cfg, 3)
-
-	assert.Equal(t, teststats.StatusFlaky, cr.Status)
-	assert.Equal(t, 3, cr.Attempts)
-}
-
 func TestCaseResult_StoresMetaAndFields(t *testing.T) {
 	cfg := &axiom.Config{
 		SubT: t,
@@ -1,25 +1,37 @@
 package teststats
 
 import (
+	"errors"
+	"time"
+
 	"github.com/Nikita-Filonov/axiom"
 )
 
 func Plugin(stats *Stats) axiom.Plugin {
 	return func(cfg *axiom.Config) {
-		result := NewCaseResult(cfg)
-		attempts := 0
-
-		cfg.Hooks.BeforeTest = append(
-			cfg.Hooks.BeforeTest,
-			func(_ *axiom.Config) { attempts++ },
-		)
+		var failure error
 
-		cfg.Hooks.AfterTest = append(
-			cfg.Hooks.AfterTest,
-			func(c *axiom.Config) {
-				result.Finalize(c, attempts)
-				stats.Record(result)
-			},
-		)
+		cfg.Runtime.EmitEventSink(func(e axiom.Event) {
+			switch e.Type {
+			case axiom.EventTypeCaseFailed:
+				if e.Message != "" {
+					failure = errors.New(e.Message)
+				}
+			case axiom.EventTypeAttemptFinish:
+				stats.RecordAttempt(cfg, AttemptOutcome{
+					Status:   e.Message,
+					Attempt:  cfg.Attempt,
+					Duration: e.Duration,
+					Error:    failure,
+				})
+			}
+		})
 	}
 }
+
+type AttemptOutcome struct {
+	Status   string
+	Attempt  int
+	Duration time.Duration
+	Error    error
+}
@@ -6,126 +6,100 @@ import (
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/Nikita-Filonov/axiom/plugins/teststats"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPlugin_RecordsPassedCase(t *testing.T) {
 	stats := teststats.NewStats()
-	plugin := teststats.Plugin(stats)
-
-	cfg := &axiom.Config{
-		Case: &axiom.Case{ID: "id1", Name: "case1"},
-		Meta: axiom.Meta{},
-		Hooks: axiom.Hooks{
-			BeforeTest: []axiom.TestHook{},
-			AfterTest:  []axiom.TestHook{},
-		},
-	}
-
-	plugin(cfg)
-
-	for _, h := range cfg.Hooks.BeforeTest {
-		h(cfg)
-	}
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(teststats.Plugin(stats)))
 
-	cfg.SubT = &testing.T{}
-
-	for _, h := range cfg.Hooks.AfterTest {
-		h(cfg)
-	}
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseID("id1"), axiom.WithCaseName("case1")), func(cfg *axiom.Config) {})
 
 	assert.Equal(t, 1, stats.Total)
 	assert.Equal(t, 1, stats.Passed)
-	assert.Len(t, stats.Cases, 1)
+	require.Len(t, stats.Cases, 1)
+	assert.Equal(t, "id1", stats.Cases[0].ID)
 	assert.Equal(t, "case1", stats.Cases[0].Name)
+	assert.Equal(t, 1, stats.Cases[0].Attempts)
 	assert.Equal(t, teststats.StatusPassed, stats.Cases[0].Status)
+	assert.Positive(t, stats.Cases[0].Duration)
 }
 
 func TestPlugin_RecordsFailedCase(t *testing.T) {
 	stats := teststats.NewStats()
-	plugin := teststats.Plugin(stats)
-
-	cfg := &axiom.Config{
-		Case: &axiom.Case{ID: "id2", Name: "case2"},
-		Meta: axiom.Meta{},
-		Hooks: axiom.Hooks{
-			BeforeTest: []axiom.TestHook{},
-			AfterTest:  []axiom.TestHook{},
-		},
-	}
-
-	plugin(cfg)
+	cfg := &axiom.Config{Case: &axiom.Case{ID: "id2", Name: "case2"}, Attempt: 1}
 
-	for _, h := range cfg.Hooks.BeforeTest {
-		h(cfg)
-	}
-
-	fakeT := &testing.T{}
-	fakeT.Fail()
-	cfg.SubT = fakeT
-
-	for _, h := range cfg.Hooks.AfterTest {
-		h(cfg)
-	}
+	teststats.Plugin(stats)(cfg)
+	cfg.Event(axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage("status 500")))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage("failed")))
 
 	assert.Equal(t, 1, stats.Failed)
+	require.Len(t, stats.Cases, 1)
 	assert.Equal(t, teststats.StatusFailed, stats.Cases[0].Status)
+	assert.EqualError(t, stats.Cases[0].Error, "status 500")
 }
 
 func TestPlugin_RecordsSkippedCase(t *testing.T) {
 	stats := teststats.NewStats()
-	plugin := teststats.Plugin(stats)
-
-	cfg := &axiom.Config{
-		Case: &axiom.Case{ID: "id3", Name: "case3"},
-		Skip: axiom.Skip{Enabled: true},
-		Hooks: axiom.Hooks{
-			BeforeTest: []axiom.TestHook{},
-			AfterTest:  []axiom.TestHook{},
-		},
-	}
-
-	plugin(cfg)
-
-	for _, h := range cfg.Hooks.BeforeTest {
-		h(cfg)
-	}
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(teststats.Plugin(stats)))
 
-	cfg.SubT = &testing.T{}
-
-	for _, h := range cfg.Hooks.AfterTest {
-		h(cfg)
-	}
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseName("case3"),
+		axiom.WithCaseSkip(axiom.SkipBecause("not today")),
+	), func(cfg *axiom.Config) {})
 
 	assert.Equal(t, 1, stats.Skipped)
+	require.Len(t, stats.Cases, 1)
 	assert.Equal(t, teststats.StatusSkipped, stats.Cases[0].Status)
 }
 
-func TestPlugin_RecordsFlakyCase(t *testing.T) {
+func TestPlugin_RecordsFlakyCaseOnce(t *testing.T) {
 	stats := teststats.NewStats()
-	plugin := teststats.Plugin(stats)
-
-	cfg := &axiom.Config{
-		Case: &axiom.Case{ID: "id4", Name: "case4"},
-		Meta: axiom.Meta{},
-		Hooks: axiom.Hooks{
-			BeforeTest: []axiom.TestHook{},
-			AfterTest:  []axiom.TestHook{},
-		},
+
+	for attempt, status := range []string{"failed", "failed", "passed"} {
+		cfg := &axiom.Config{RootT: t, Case: &axiom.Case{ID: "id4", Name: "case4"}, Attempt: attempt + 1}
+		teststats.Plugin(stats)(cfg)
+		cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage(status)))
 	}
 
-	plugin(cfg)
+	assert.Equal(t, 1, stats.Total)
+	assert.Equal(t, 1, stats.Flaky)
+	assert.Equal(t, 0, stats.Failed)
+	require.Len(t, stats.Cases, 1)
+	assert.Equal(t, teststats.StatusFlaky, stats.Cases[0].Status)
+	assert.Equal(t, 3, stats.Cases[0].Attempts)
+}
 
-	for _, h := range cfg.Hooks.BeforeTest {
-		h(cfg)
-		h(cfg)
-	}
+func TestPlugin_RecordsQuarantinedCase(t *testing.T) {
+	stats := teststats.NewStats()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(teststats.Plugin(stats)))
+
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseName("case5"),
+		axiom.WithCaseQuarantine(axiom.QuarantineBecause("JIRA-1")),
+	), func(cfg *axiom.Config) {
+		cfg.Step("flaky", func() { panic("boom") })
+	})
+
+	assert.Equal(t, 1, stats.Quarantined)
+	require.Len(t, stats.Cases, 1)
+	assert.True(t, stats.Cases[0].Quarantined)
+	assert.Equal(t, teststats.StatusQuarantined, stats.Cases[0].Status)
+}
 
-	cfg.SubT = &testing.T{}
+func TestPlugin_CountsQuarantinedAttemptStatusSeparately(t *testing.T) {
+	stats := teststats.NewStats()
 
-	for _, h := range cfg.Hooks.AfterTest {
-		h(cfg)
-	}
+	cfg := &axiom.Config{RootT: t, Case: &axiom.Case{ID: "id6", Name: "case6"}, Attempt: 1}
+	teststats.Plugin(stats)(cfg)
+	cfg.Event(axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage("boom")))
+	cfg.Event(axiom.NewEvent(axiom.EventTypeAttemptFinish, axiom.WithEventMessage(teststats.StatusQuarantined)))
 
-	assert.Equal(t, 1, stats.Flaky)
-	assert.Equal(t, teststats.StatusFlaky, stats.Cases[0].Status)
+	assert.Equal(t, 1, stats.Total)
+	assert.Equal(t, 1, stats.Quarantined)
+	assert.Equal(t, 0, stats.Failed)
+	assert.Equal(t, 0, stats.Passed)
+	require.Len(t, stats.Cases, 1)
+	assert.Equal(t, teststats.StatusQuarantined, stats.Cases[0].Status)
+	assert.EqualError(t, stats.Cases[0].Error, "boom")
 }
@@ -2,6 +2,9 @@ package teststats
 
 import (
 	"sync"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
 )
 
 type Stats struct {
@@ -13,7 +16,11 @@ type Stats struct {
 	Skipped int
 	Flaky   int
 
+	Quarantined int
+
 	Cases []*CaseResult
+
+	attempts map[string]*CaseResult
 }
 
 func NewStats() *Stats {
@@ -25,17 +32,92 @@ func (s *Stats) Record(cr *CaseResult) {
 	defer s.mu.Unlock()
 
 	s.Total++
+	s.count(cr.Status, 1)
+	s.Cases = append(s.Cases, cr)
+}
+
+// RecordAttempt folds the outcome of one attempt into the result of its case,
+// so retried cases are counted once with their final status.
+func (s *Stats) RecordAttempt(cfg *axiom.Config, outcome AttemptOutcome) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	switch cr.Status {
+	key := caseKey(cfg)
+	cr, ok := s.attempts[key]
+	if ok {
+		s.count(cr.Status, -1)
+	} else {
+		cr = NewCaseResult(cfg)
+		cr.Start = time.Now().Add(-outcome.Duration)
+
+		if s.attempts == nil {
+			s.attempts = map[string]*CaseResult{}
+		}
+		s.attempts[key] = cr
+		s.Cases = append(s.Cases, cr)
+		s.Total++
+	}
+
+	cr.Attempts = outcome.Attempt
+	cr.End = time.Now()
+	cr.Duration = cr.End.Sub(cr.Start)
+	cr.Error = outcome.Error
+	cr.Quarantined = cfg.Quarantine.Enabled
+	cr.Status = attemptStatus(outcome)
+
+	s.count(cr.Status, 1)
+}
+
+func (s *Stats) count(status string, delta int) {
+	switch status {
 	case StatusPassed:
-		s.Passed++
+		s.Passed += delta
 	case StatusFailed:
-		s.Failed++
+		s.Failed += delta
 	case StatusSkipped:
-		s.Skipped++
+		s.Skipped += delta
 	case StatusFlaky:
-		s.Flaky++
+		s.Flaky += delta
+	case StatusQuarantined:
+		s.Quarantined += delta
 	}
+}
 
-	s.Cases = append(s.Cases, cr)
+func attemptStatus(outcome AttemptOutcome) string {
+	switch {
+	case outcome.Status == StatusQuarantined:
+		return StatusQuarantined
+	case outcome.Status == StatusPassed && outcome.Attempt > 1:
+		return StatusFlaky
+	default:
+		return outcome.Status
+	}
+}
+
+func caseKey(cfg *axiom.Config) string {
+	key := ""
+	if cfg.RootT != nil {
+		key = cfg.RootT.Name()
+	}
+	if cfg.Case != nil {
+		key += "/" + cfg.Case.ID + "/" + cfg.Case.Name
+	}
+
+	return key
+}
+
+// Releasable returns quarantined cases that passed despite the quarantine,
+// i.e. candidates for removal from the quarantine list.
+func (s *Stats) Releasable() []*CaseResult {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []*CaseResult
+	for _, cr := range s.Cases {
+		if cr.Quarantined && (cr.Status == StatusPassed || cr.Status == StatusFlaky) {
+			result = append(result, cr)
+		}
+	}
+
+	return result
 }
@@ -110,3 +110,29 @@ func TestStats_Record_Concurrent(t *testing.T) {
 	assert.Equal(t, 100, s.Passed)
 	assert.Len(t, s.Cases, 100)
 }
+
+func TestStats_Record_Quarantined(t *testing.T) {
+	s := teststats.NewStats()
+
+	s.Record(newCR(teststats.StatusQuarantined))
+
+	assert.Equal(t, 1, s.Total)
+	assert.Equal(t, 0, s.Failed)
+	assert.Equal(t, 1, s.Quarantined)
+}
+
+func TestStats_Releasable_ReturnsQuarantinedPassedCases(t *testing.T) {
+	s := teststats.NewStats()
+
+	stillFlaky := newCR(teststats.StatusQuarantined)
+	stillFlaky.Quarantined = true
+
+	fixed := newCR(teststats.StatusPassed)
+	fixed.Quarantined = true
+
+	s.Record(stillFlaky)
+	s.Record(fixed)
+	s.Record(newCR(teststats.StatusPassed))
+
+	assert.Equal(t, []*teststats.CaseResult{fixed}, s.Releasable())
+}
@@ -0,0 +1,71 @@
+package teststats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+type Timing struct {
+	ID       string        `json:"id,omitempty"`
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+}
+
+// Timings returns durations of the cases that actually ran. Skipped cases are
+// left out so that timings of a sharded run only describe the local shard.
+func (s *Stats) Timings() []Timing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]Timing, 0, len(s.Cases))
+	for _, cr := range s.Cases {
+		if cr.Status == StatusSkipped {
+			continue
+		}
+		result = append(result, Timing{ID: cr.ID, Name: cr.Name, Duration: cr.Duration})
+	}
+
+	return result
+}
+
+func (s *Stats) WriteTimings(path string) error {
+	data, err := json.MarshalIndent(s.Timings(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, data, 0o644)
+}
+
+func ReadTimings(path string) ([]Timing, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var timings []Timing
+	if err := json.Unmarshal(data, &timings); err != nil {
+		return nil, err
+	}
+
+	return timings, nil
+}
+
+func WriteTimings(stats *Stats, path string) axiom.AllHook {
+	return func(_ *axiom.Runner) {
+		if err := stats.WriteTimings(path); err != nil {
+			panic(fmt.Sprintf("teststats: write timings %q: %v", path, err))
+		}
+	}
+}
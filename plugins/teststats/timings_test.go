@@ -0,0 +1,46 @@
+package teststats_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/teststats"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats_Timings_SkipsSkippedCases(t *testing.T) {
+	s := teststats.NewStats()
+
+	s.Record(&teststats.CaseResult{ID: "AX-1", Name: "a", Status: teststats.StatusPassed, Duration: time.Second})
+	s.Record(&teststats.CaseResult{Name: "b", Status: teststats.StatusFailed, Duration: 2 * time.Second})
+	s.Record(&teststats.CaseResult{Name: "c", Status: teststats.StatusSkipped})
+
+	assert.Equal(t, []teststats.Timing{
+		{ID: "AX-1", Name: "a", Duration: time.Second},
+		{Name: "b", Duration: 2 * time.Second},
+	}, s.Timings())
+}
+
+func TestStats_WriteTimings_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out", "timings.json")
+
+	s := teststats.NewStats()
+	s.Record(&teststats.CaseResult{ID: "AX-1", Name: "a", Status: teststats.StatusPassed, Duration: time.Second})
+
+	require.NoError(t, s.WriteTimings(path))
+
+	timings, err := teststats.ReadTimings(path)
+	require.NoError(t, err)
+	assert.Equal(t, s.Timings(), timings)
+}
+
+func TestWriteTimings_PanicsOnWriteError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "\x00", "timings.json")
+
+	hook := teststats.WriteTimings(teststats.NewStats(), path)
+
+	assert.Panics(t, func() { hook(&axiom.Runner{}) })
+}
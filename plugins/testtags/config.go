@@ -1,15 +1,20 @@
 package testtags
 
-import "os"
+import (
+	"os"
+	"strings"
+)
 
 const (
 	AxiomTestTagsExclude = "AXIOM_TEST_TAGS_EXCLUDE"
 	AxiomTestTagsInclude = "AXIOM_TEST_TAGS_INCLUDE"
+	AxiomTestFilter      = "AXIOM_TEST_FILTER"
 )
 
 type Config struct {
 	Include []string
 	Exclude []string
+	Filter  string
 }
 
 type ConfigOption func(*Config)
@@ -38,9 +43,25 @@ func WithConfigExclude(tags ...string) ConfigOption {
 	}
 }
 
+// WithConfigFilter sets a boolean filter expression, see ParseExpression.
+// Repeated filters are combined with "&&".
+func WithConfigFilter(expr string) ConfigOption {
+	return func(c *Config) {
+		expr = strings.TrimSpace(expr)
+		switch {
+		case expr == "":
+		case c.Filter == "":
+			c.Filter = expr
+		default:
+			c.Filter = "(" + c.Filter + ") && (" + expr + ")"
+		}
+	}
+}
+
 func ConfigFromEnv() ConfigOption {
 	return func(c *Config) {
 		c.Include = append(c.Include, ParseList(os.Getenv(AxiomTestTagsInclude))...)
 		c.Exclude = append(c.Exclude, ParseList(os.Getenv(AxiomTestTagsExclude))...)
+		WithConfigFilter(os.Getenv(AxiomTestFilter))(c)
 	}
 }
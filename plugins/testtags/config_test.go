@@ -104,3 +104,23 @@ func TestConfig_CombinedOptions(t *testing.T) {
 	assert.Equal(t, []string{"api", "net"}, cfg.Include)
 	assert.Equal(t, []string{"slow"}, cfg.Exclude)
 }
+
+func TestConfigFromEnv_ParsesFilter(t *testing.T) {
+	t.Setenv(testtags.AxiomTestFilter, " smoke && !slow ")
+
+	cfg := testtags.NewConfig(
+		testtags.ConfigFromEnv(),
+	)
+
+	assert.Equal(t, "smoke && !slow", cfg.Filter)
+}
+
+func TestWithConfigFilter_CombinesFilters(t *testing.T) {
+	cfg := testtags.NewConfig(
+		testtags.WithConfigFilter("api || grpc"),
+		testtags.WithConfigFilter(""),
+		testtags.WithConfigFilter("!slow"),
+	)
+
+	assert.Equal(t, "(api || grpc) && (!slow)", cfg.Filter)
+}
@@ -0,0 +1,316 @@
+package testtags
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nikita-Filonov/axiom"
+)
+
+var severityRanks = map[string]int{
+	string(axiom.SeverityTrivial):  1,
+	string(axiom.SeverityMinor):    2,
+	string(axiom.SeverityNormal):   3,
+	string(axiom.SeverityCritical): 4,
+	string(axiom.SeverityBlocker):  5,
+}
+
+type Expression interface {
+	Match(meta axiom.Meta) bool
+}
+
+type ParseError struct {
+	Expr    string
+	Pos     int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse filter %q at position %d: %s", e.Expr, e.Pos, e.Message)
+}
+
+// ParseExpression parses a boolean filter such as
+// `(api || grpc) && !slow && severity>=critical && team=payments`.
+//
+// A bare word matches Meta.Tags. `key op value` matches Meta.Feature,
+// Meta.Layer, Meta.Severity or, for any other key, Meta.Labels. Ordering
+// operators are only supported for severity.
+func ParseExpression(s string) (Expression, error) {
+	p := &parser{input: s}
+	if err := p.tokenize(); err != nil {
+		return nil, err
+	}
+	if len(p.tokens) == 0 {
+		return nil, p.errorf(0, "empty expression")
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok.pos, fmt.Sprintf("unexpected %q", tok.text))
+	}
+
+	return expr, nil
+}
+
+type andExpr struct{ left, right Expression }
+
+func (e andExpr) Match(meta axiom.Meta) bool { return e.left.Match(meta) && e.right.Match(meta) }
+
+type orExpr struct{ left, right Expression }
+
+func (e orExpr) Match(meta axiom.Meta) bool { return e.left.Match(meta) || e.right.Match(meta) }
+
+type notExpr struct{ expr Expression }
+
+func (e notExpr) Match(meta axiom.Meta) bool { return !e.expr.Match(meta) }
+
+type tagExpr struct{ tag string }
+
+func (e tagExpr) Match(meta axiom.Meta) bool {
+	_, ok := MapList(meta.Tags)[e.tag]
+	return ok
+}
+
+type fieldExpr struct {
+	key   string
+	op    string
+	value string
+}
+
+func (e fieldExpr) Match(meta axiom.Meta) bool {
+	actual, ok := fieldValue(meta, e.key)
+
+	switch e.op {
+	case "=":
+		return ok && strings.EqualFold(actual, e.value)
+	case "!=":
+		return !ok || !strings.EqualFold(actual, e.value)
+	}
+
+	rank, ok := severityRanks[strings.ToLower(actual)]
+	if !ok {
+		return false
+	}
+
+	expected := severityRanks[e.value]
+	switch e.op {
+	case ">=":
+		return rank >= expected
+	case "<=":
+		return rank <= expected
+	case ">":
+		return rank > expected
+	default:
+		return rank < expected
+	}
+}
+
+func fieldValue(meta axiom.Meta, key string) (string, bool) {
+	switch key {
+	case "feature":
+		return meta.Feature, meta.Feature != ""
+	case "layer":
+		return meta.Layer, meta.Layer != ""
+	case "severity":
+		return string(meta.Severity), meta.Severity != ""
+	}
+
+	for k, v := range meta.Labels {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+const (
+	tokenEOF = iota
+	tokenWord
+	tokenAnd
+	tokenOr
+	tokenNot
+	tokenLParen
+	tokenRParen
+	tokenOp
+)
+
+type token struct {
+	kind int
+	text string
+	pos  int
+}
+
+type parser struct {
+	input  string
+	tokens []token
+	index  int
+}
+
+func (p *parser) tokenize() error {
+	s := p.input
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case strings.HasPrefix(s[i:], "&&"):
+			p.tokens = append(p.tokens, token{kind: tokenAnd, text: "&&", pos: i})
+			i += 2
+		case strings.HasPrefix(s[i:], "||"):
+			p.tokens = append(p.tokens, token{kind: tokenOr, text: "||", pos: i})
+			i += 2
+		case strings.HasPrefix(s[i:], "!="), strings.HasPrefix(s[i:], ">="), strings.HasPrefix(s[i:], "<="):
+			p.tokens = append(p.tokens, token{kind: tokenOp, text: s[i : i+2], pos: i})
+			i += 2
+		case c == '=' || c == '>' || c == '<':
+			p.tokens = append(p.tokens, token{kind: tokenOp, text: string(c), pos: i})
+			i++
+		case c == '!':
+			p.tokens = append(p.tokens, token{kind: tokenNot, text: "!", pos: i})
+			i++
+		case c == '(':
+			p.tokens = append(p.tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			p.tokens = append(p.tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == '"':
+			end := strings.IndexByte(s[i+1:], '"')
+			if end < 0 {
+				return p.errorf(i, "unterminated string")
+			}
+			p.tokens = append(p.tokens, token{kind: tokenWord, text: s[i+1 : i+1+end], pos: i})
+			i += end + 2
+		case isWordByte(c):
+			start := i
+			for i < len(s) && isWordByte(s[i]) {
+				i++
+			}
+			p.tokens = append(p.tokens, token{kind: tokenWord, text: s[start:i], pos: start})
+		default:
+			return p.errorf(i, fmt.Sprintf("unexpected character %q", c))
+		}
+	}
+
+	return nil
+}
+
+func isWordByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '_' || c == '-' || c == '.' || c == '/' || c == ':'
+}
+
+func (p *parser) peek() token {
+	if p.index >= len(p.tokens) {
+		return token{kind: tokenEOF, text: "end of expression", pos: len(p.input)}
+	}
+	return p.tokens[p.index]
+}
+
+func (p *parser) next() token {
+	tok := p.peek()
+	if tok.kind != tokenEOF {
+		p.index++
+	}
+	return tok
+}
+
+func (p *parser) errorf(pos int, message string) error {
+	return &ParseError{Expr: p.input, Pos: pos, Message: message}
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = andExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expression, error) {
+	if p.peek().kind == tokenNot {
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expression, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenLParen:
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.errorf(closing.pos, fmt.Sprintf("expected \")\", got %q", closing.text))
+		}
+		return expr, nil
+	case tokenWord:
+		if p.peek().kind != tokenOp {
+			return tagExpr{tag: NormalizeTag(tok.text)}, nil
+		}
+		return p.parseField(tok)
+	default:
+		return nil, p.errorf(tok.pos, fmt.Sprintf("expected tag, field or \"(\", got %q", tok.text))
+	}
+}
+
+func (p *parser) parseField(key token) (Expression, error) {
+	op := p.next()
+	value := p.next()
+	if value.kind != tokenWord {
+		return nil, p.errorf(value.pos, fmt.Sprintf("expected value after %q, got %q", op.text, value.text))
+	}
+
+	field := fieldExpr{key: NormalizeTag(key.text), op: op.text, value: strings.TrimSpace(value.text)}
+	if field.key == "severity" || op.text == "=" || op.text == "!=" {
+		if field.key == "severity" {
+			field.value = NormalizeTag(field.value)
+			if _, ok := severityRanks[field.value]; !ok {
+				return nil, p.errorf(value.pos, fmt.Sprintf("unknown severity %q", value.text))
+			}
+		}
+		return field, nil
+	}
+
+	return nil, p.errorf(op.pos, fmt.Sprintf("operator %q is only supported for severity", op.text))
+}
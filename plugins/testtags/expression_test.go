@@ -0,0 +1,80 @@
+package testtags_test
+
+import (
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/Nikita-Filonov/axiom/plugins/testtags"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExpression_Match(t *testing.T) {
+	meta := axiom.Meta{
+		Tags:     []string{"Smoke", "api"},
+		Labels:   map[string]string{"team": "payments"},
+		Feature:  "checkout",
+		Layer:    "e2e",
+		Severity: axiom.SeverityCritical,
+	}
+
+	cases := map[string]bool{
+		"smoke":                                 true,
+		"slow":                                  false,
+		"smoke && !slow":                        true,
+		"!smoke":                                false,
+		"slow || api":                           true,
+		"(api || grpc) && severity>=critical":   true,
+		"(api || grpc) && severity>blocker":     false,
+		"severity>=blocker":                     false,
+		"severity<=normal":                      false,
+		"severity<blocker":                      true,
+		"team=payments":                         true,
+		"TEAM=Payments":                         true,
+		"team!=payments":                        false,
+		"owner!=payments":                       true,
+		"feature=checkout && layer=e2e":         true,
+		`feature="checkout" && layer!=unit`:     true,
+		"smoke && (team=search || layer=e2e)":   true,
+		"!(smoke && api)":                       false,
+		"!!smoke":                               true,
+		"smoke || api && slow":                  true,
+		"(smoke || api) && slow":                false,
+		"severity=critical && feature!=billing": true,
+	}
+
+	for input, expected := range cases {
+		expr, err := testtags.ParseExpression(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, expr.Match(meta), input)
+	}
+}
+
+func TestParseExpression_EmptySeverityNeverMatchesOrdering(t *testing.T) {
+	expr, err := testtags.ParseExpression("severity<=critical")
+	require.NoError(t, err)
+
+	assert.False(t, expr.Match(axiom.Meta{}))
+}
+
+func TestParseExpression_Errors(t *testing.T) {
+	cases := map[string]string{
+		"":                 `parse filter "" at position 0: empty expression`,
+		"smoke &&":         `parse filter "smoke &&" at position 8: expected tag, field or "(", got "end of expression"`,
+		"(smoke":           `parse filter "(smoke" at position 6: expected ")", got "end of expression"`,
+		"smoke)":           `parse filter "smoke)" at position 5: unexpected ")"`,
+		"smoke & api":      `parse filter "smoke & api" at position 6: unexpected character '&'`,
+		"team>=payments":   `parse filter "team>=payments" at position 4: operator ">=" is only supported for severity`,
+		"severity>=urgent": `parse filter "severity>=urgent" at position 10: unknown severity "urgent"`,
+		"team=":            `parse filter "team=" at position 5: expected value after "=", got "end of expression"`,
+		`team="payments`:   `parse filter "team=\"payments" at position 5: unterminated string`,
+		"smoke api":        `parse filter "smoke api" at position 6: unexpected "api"`,
+	}
+
+	for input, expected := range cases {
+		_, err := testtags.ParseExpression(input)
+		if assert.Error(t, err, input) {
+			assert.Equal(t, expected, err.Error(), input)
+		}
+	}
+}
@@ -1,12 +1,23 @@
 package testtags
 
 import (
+	"fmt"
+
 	"github.com/Nikita-Filonov/axiom"
 )
 
 func Plugin(options ...ConfigOption) axiom.Plugin {
 	cfg := NewConfig(options...)
 
+	var filter Expression
+	if cfg.Filter != "" {
+		expr, err := ParseExpression(cfg.Filter)
+		if err != nil {
+			panic(fmt.Sprintf("testtags: %v", err))
+		}
+		filter = expr
+	}
+
 	return func(e *axiom.Config) {
 		testTags := MapList(e.Meta.Tags)
 
@@ -19,5 +30,10 @@ func Plugin(options ...ConfigOption) axiom.Plugin {
 			e.Skip = axiom.NewSkip(axiom.SkipBecause("not included by tag filter"))
 			return
 		}
+
+		if filter != nil && !filter.Match(e.Meta) {
+			e.Skip = axiom.NewSkip(axiom.SkipBecause(fmt.Sprintf("not matched by filter %q", cfg.Filter)))
+			return
+		}
 	}
 }
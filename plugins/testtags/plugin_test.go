@@ -138,3 +138,31 @@ func TestRunner_WithTagsPlugin_Included(t *testing.T) {
 
 	assert.True(t, called, "test should run because tag matches include")
 }
+
+func TestPlugin_FilterMatch(t *testing.T) {
+	p := testtags.Plugin(testtags.WithConfigFilter("smoke && !slow"))
+
+	cfg := &axiom.Config{Meta: axiom.Meta{Tags: []string{"smoke"}}}
+
+	p(cfg)
+
+	assert.False(t, cfg.Skip.Enabled)
+}
+
+func TestPlugin_FilterNoMatch(t *testing.T) {
+	p := testtags.Plugin(testtags.WithConfigFilter("smoke && !slow"))
+
+	cfg := &axiom.Config{Meta: axiom.Meta{Tags: []string{"smoke", "slow"}}}
+
+	p(cfg)
+
+	assert.True(t, cfg.Skip.Enabled)
+	assert.Equal(t, `not matched by filter "smoke && !slow"`, cfg.Skip.Reason)
+}
+
+func TestPlugin_FilterParseErrorPanics(t *testing.T) {
+	assert.PanicsWithValue(t,
+		`testtags: parse filter "smoke &&" at position 8: expected tag, field or "(", got "end of expression"`,
+		func() { testtags.Plugin(testtags.WithConfigFilter("smoke &&")) },
+	)
+}
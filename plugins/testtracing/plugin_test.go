@@ -1,6 +1,7 @@
 package testtracing_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -48,7 +49,7 @@ func TestPlugin_PreservesConfigEventsAsIs(t *testing.T) {
 	if len(records[0].Events) != 1 {
 		t.Fatalf("expected one event, got %d", len(records[0].Events))
 	}
-	if records[0].Events[0] != (axiom.Event{Type: axiom.EventTypeLog, Message: "raw"}) {
+	if !reflect.DeepEqual(records[0].Events[0], axiom.Event{Type: axiom.EventTypeLog, Message: "raw"}) {
 		t.Fatalf("unexpected event: %#v", records[0].Events[0])
 	}
 }
@@ -230,3 +231,62 @@ func TestTraceSnapshot_CopiesRecords(t *testing.T) {
 		t.Fatalf("snapshot mutation changed events: %#v", again[0].Events)
 	}
 }
+
+func TestPlugin_RecordsAttemptStatus(t *testing.T) {
+	trace := testtracing.NewTrace()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testtracing.Plugin(trace)))
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("passed")), func(cfg *axiom.Config) {})
+	runner.RunCase(t, axiom.NewCase(
+		axiom.WithCaseName("skipped"),
+		axiom.WithCaseSkip(axiom.SkipBecause("not today")),
+	), func(cfg *axiom.Config) {})
+
+	records := trace.Snapshot()
+	if len(records) != 2 {
+		t.Fatalf("expected two records, got %d", len(records))
+	}
+	if records[0].Status != "passed" || records[1].Status != "skipped" {
+		t.Fatalf("unexpected statuses: %q, %q", records[0].Status, records[1].Status)
+	}
+}
+
+func TestPlugin_RecordsParallelStepNesting(t *testing.T) {
+	trace := testtracing.NewTrace()
+	runner := axiom.NewRunner(axiom.WithRunnerPlugins(testtracing.Plugin(trace)))
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("create users")), func(cfg *axiom.Config) {
+		cfg.Step("create users", func() {
+			cfg.ParallelSteps(map[string]func(cfg *axiom.Config){
+				"user 1": func(cfg *axiom.Config) { cfg.Step("insert user 1", func() {}) },
+				"user 2": func(cfg *axiom.Config) { cfg.Step("insert user 2", func() {}) },
+			})
+		})
+	})
+
+	records := trace.Snapshot()
+	if len(records) != 1 {
+		t.Fatalf("expected one record, got %d", len(records))
+	}
+
+	starts := map[string]axiom.Event{}
+	for _, event := range records[0].Events {
+		if event.Type == axiom.EventTypeStepStart {
+			starts[event.Name] = event
+		}
+	}
+
+	group := starts["create users"]
+	for _, name := range []string{"user 1", "user 2"} {
+		branch, insert := starts[name], starts["insert "+name]
+		if branch.ParentID != group.ID {
+			t.Fatalf("step %q is not nested under the group: %#v", name, branch)
+		}
+		if insert.ParentID != branch.ID {
+			t.Fatalf("step %q is not nested under its branch: %#v", insert.Name, insert)
+		}
+		if !reflect.DeepEqual(insert.Steps, []string{"create users", name, "insert " + name}) {
+			t.Fatalf("unexpected step path: %#v", insert.Steps)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package testtracing
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/Nikita-Filonov/axiom"
@@ -14,6 +15,7 @@ type Trace struct {
 type TraceRecord struct {
 	Case   axiom.Case
 	Meta   axiom.Meta
+	Status string
 	Events []axiom.Event
 }
 
@@ -42,6 +44,11 @@ func (t *Trace) AppendToRecord(index int, event axiom.Event) {
 	defer t.mu.Unlock()
 
 	t.records[index].Events = append(t.records[index].Events, event)
+
+	switch event.Type {
+	case axiom.EventTypeCasePassed, axiom.EventTypeCaseFailed, axiom.EventTypeCaseSkipped:
+		t.records[index].Status = strings.TrimPrefix(event.Type.String(), "case.")
+	}
 }
 
 func (t *Trace) Snapshot() []TraceRecord {
@@ -53,6 +60,7 @@ func (t *Trace) Snapshot() []TraceRecord {
 		records[i] = TraceRecord{
 			Case:   record.Case.Copy(),
 			Meta:   record.Meta.Copy(),
+			Status: record.Status,
 			Events: append([]axiom.Event{}, record.Events...),
 		}
 	}
@@ -0,0 +1,133 @@
+package axiom
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const DefaultPollInterval = 100 * time.Millisecond
+
+const PollAttrAttempt = "poll_attempt"
+
+type pollMode string
+
+const (
+	pollEventually   pollMode = "eventually"
+	pollConsistently pollMode = "consistently"
+)
+
+type poll struct {
+	mode     pollMode
+	name     string
+	timeout  time.Duration
+	interval time.Duration
+	fn       func() error
+
+	attempts int
+	lastErr  error
+}
+
+// Eventually calls fn every interval until it returns nil or the timeout
+// expires. The polling runs as a single step; every call emits a poll.attempt
+// event, the attempt count is attached to the step as an artefact, and on
+// failure the last error is reported through cfg.Errorf.
+func (c *Config) Eventually(name string, timeout, interval time.Duration, fn func() error) bool {
+	return c.poll(&poll{mode: pollEventually, name: name, timeout: timeout, interval: interval, fn: fn})
+}
+
+// Consistently calls fn every interval for the whole duration and fails on
+// the first error.
+func (c *Config) Consistently(name string, duration, interval time.Duration, fn func() error) bool {
+	return c.poll(&poll{mode: pollConsistently, name: name, timeout: duration, interval: interval, fn: fn})
+}
+
+func (c *Config) poll(p *poll) bool {
+	if p.fn == nil {
+		panic(fmt.Sprintf("poll: nil function for %s %q", p.mode, p.name))
+	}
+	if p.interval <= 0 {
+		p.interval = DefaultPollInterval
+	}
+
+	var ok bool
+	c.Step(fmt.Sprintf("%s: %s", p.mode, p.name), func() {
+		ok = c.runPoll(p)
+	})
+
+	return ok
+}
+
+func (c *Config) runPoll(p *poll) bool {
+	ctx := c.Context.Raw
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	deadline := time.Now().Add(p.timeout)
+	for {
+		p.attempts++
+		p.lastErr = p.fn()
+		c.emitPollAttempt(p)
+
+		switch {
+		case p.mode == pollEventually && p.lastErr == nil:
+			return c.passPoll(p)
+		case p.mode == pollConsistently && p.lastErr != nil:
+			return c.failPoll(p, "failed")
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			if p.mode == pollConsistently {
+				return c.passPoll(p)
+			}
+			return c.failPoll(p, fmt.Sprintf("not satisfied within %s", p.timeout))
+		}
+
+		timer := time.NewTimer(min(p.interval, remaining))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			p.lastErr = errors.Join(p.lastErr, context.Cause(ctx))
+			return c.failPoll(p, "interrupted")
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Config) emitPollAttempt(p *poll) {
+	message := "ok"
+	if p.lastErr != nil {
+		message = p.lastErr.Error()
+	}
+
+	c.emit(NewEvent(
+		EventTypePollAttempt,
+		WithEventName(p.name),
+		WithEventMessage(message),
+		WithEventAttrs(NewAttr(PollAttrAttempt, p.attempts)),
+	))
+}
+
+func (c *Config) passPoll(p *poll) bool {
+	message := fmt.Sprintf("%s %q satisfied after %d attempt(s)", p.mode, p.name, p.attempts)
+
+	c.Artefact(NewTextArtefact(fmt.Sprintf("%s: %s attempts", p.mode, p.name), message))
+	c.Log(NewInfoLog(message))
+
+	return true
+}
+
+func (c *Config) failPoll(p *poll, reason string) bool {
+	message := fmt.Sprintf("%s %q %s after %d attempt(s)", p.mode, p.name, reason, p.attempts)
+	if p.lastErr != nil {
+		message += ": " + p.lastErr.Error()
+	}
+
+	c.Artefact(NewTextArtefact(fmt.Sprintf("%s: %s last failure", p.mode, p.name), message))
+	c.Errorf("%s", message)
+
+	return false
+}
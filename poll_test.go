@@ -0,0 +1,149 @@
+package axiom_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPollConfig(t *testing.T, events *[]axiom.Event, artefacts *[]axiom.Artefact) (*axiom.Config, *testing.T) {
+	t.Helper()
+
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeEventSink(func(e axiom.Event) { *events = append(*events, e) }),
+			axiom.WithRuntimeArtefactSink(func(a axiom.Artefact) { *artefacts = append(*artefacts, a) }),
+		),
+	}
+
+	return cfg, fakeT
+}
+
+func pollAttempts(events []axiom.Event) []axiom.Event {
+	var result []axiom.Event
+	for _, e := range events {
+		if e.Type == axiom.EventTypePollAttempt {
+			result = append(result, e)
+		}
+	}
+	return result
+}
+
+func TestConfig_Eventually_SucceedsAfterRetries(t *testing.T) {
+	var events []axiom.Event
+	var artefacts []axiom.Artefact
+	cfg, fakeT := newPollConfig(t, &events, &artefacts)
+
+	calls := 0
+	ok := cfg.Eventually("queue drained", time.Second, time.Millisecond, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("still 2 messages")
+		}
+		return nil
+	})
+
+	assert.True(t, ok)
+	assert.False(t, fakeT.Failed())
+	require.Len(t, artefacts, 1)
+	assert.Equal(t, "eventually: queue drained attempts", artefacts[0].Name)
+	assert.Equal(t, `eventually "queue drained" satisfied after 3 attempt(s)`, string(artefacts[0].Data))
+
+	attempts := pollAttempts(events)
+	require.Len(t, attempts, 3)
+	assert.Equal(t, "queue drained", attempts[0].Name)
+	assert.Equal(t, "still 2 messages", attempts[0].Message)
+	assert.Equal(t, "ok", attempts[2].Message)
+	assert.Equal(t, []axiom.Attr{axiom.NewAttr(axiom.PollAttrAttempt, 3)}, attempts[2].Attrs)
+	assert.Equal(t, []string{"eventually: queue drained"}, attempts[0].Steps)
+	assert.Equal(t, axiom.EventTypeStepStart, events[0].Type)
+
+	last := events[len(events)-2]
+	assert.Equal(t, axiom.EventTypeLog, last.Type)
+	assert.Equal(t, `eventually "queue drained" satisfied after 3 attempt(s)`, last.Message)
+}
+
+func TestConfig_Eventually_FailsAfterTimeout(t *testing.T) {
+	var events []axiom.Event
+	var artefacts []axiom.Artefact
+	cfg, fakeT := newPollConfig(t, &events, &artefacts)
+
+	ok := cfg.Eventually("row replicated", 20*time.Millisecond, 5*time.Millisecond, func() error {
+		return errors.New("row not found")
+	})
+
+	assert.False(t, ok)
+	assert.True(t, fakeT.Failed())
+	require.Len(t, cfg.Failures(), 1)
+	assert.Contains(t, cfg.Failures()[0], `eventually "row replicated" not satisfied within 20ms after`)
+	assert.Contains(t, cfg.Failures()[0], "row not found")
+	assert.Greater(t, len(pollAttempts(events)), 1)
+
+	require.Len(t, artefacts, 1)
+	assert.Equal(t, "eventually: row replicated last failure", artefacts[0].Name)
+	assert.Equal(t, cfg.Failures()[0], string(artefacts[0].Data))
+}
+
+func TestConfig_Eventually_StopsOnContextCancel(t *testing.T) {
+	var events []axiom.Event
+	var artefacts []axiom.Artefact
+	cfg, fakeT := newPollConfig(t, &events, &artefacts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cfg.Context = axiom.NewContext(axiom.WithContextRaw(ctx))
+
+	start := time.Now()
+	ok := cfg.Eventually("never", time.Minute, time.Millisecond, func() error {
+		cancel()
+		return errors.New("not yet")
+	})
+
+	assert.False(t, ok)
+	assert.True(t, fakeT.Failed())
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Contains(t, cfg.Failures()[0], `eventually "never" interrupted after 1 attempt(s)`)
+	assert.Contains(t, cfg.Failures()[0], "context canceled")
+}
+
+func TestConfig_Consistently(t *testing.T) {
+	var events []axiom.Event
+	var artefacts []axiom.Artefact
+	cfg, fakeT := newPollConfig(t, &events, &artefacts)
+
+	ok := cfg.Consistently("no duplicates", 15*time.Millisecond, 5*time.Millisecond, func() error {
+		return nil
+	})
+
+	assert.True(t, ok)
+	assert.False(t, fakeT.Failed())
+	assert.Greater(t, len(pollAttempts(events)), 1)
+}
+
+func TestConfig_Consistently_FailsOnFirstError(t *testing.T) {
+	var events []axiom.Event
+	var artefacts []axiom.Artefact
+	cfg, fakeT := newPollConfig(t, &events, &artefacts)
+
+	calls := 0
+	ok := cfg.Consistently("balance stable", time.Second, time.Millisecond, func() error {
+		calls++
+		if calls == 2 {
+			return errors.New("balance changed")
+		}
+		return nil
+	})
+
+	assert.False(t, ok)
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, []string{`consistently "balance stable" failed after 2 attempt(s): balance changed`}, cfg.Failures())
+	require.Len(t, artefacts, 1)
+	assert.Equal(t, "consistently: balance stable last failure", artefacts[0].Name)
+}
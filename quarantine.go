@@ -0,0 +1,66 @@
+package axiom
+
+type Quarantine struct {
+	Reason     string
+	Enabled    bool
+	EnabledSet bool
+}
+
+type QuarantineOption func(*Quarantine)
+
+func NewQuarantine(options ...QuarantineOption) Quarantine {
+	q := Quarantine{}
+	for _, option := range options {
+		option(&q)
+	}
+
+	return q
+}
+
+func WithQuarantineEnabled(enabled bool) QuarantineOption {
+	return func(q *Quarantine) {
+		q.Enabled = enabled
+		q.EnabledSet = true
+	}
+}
+
+func WithQuarantineDisabled() QuarantineOption {
+	return func(q *Quarantine) {
+		q.Enabled = false
+		q.EnabledSet = true
+	}
+}
+
+func WithQuarantineReason(reason string) QuarantineOption {
+	return func(q *Quarantine) { q.Reason = reason }
+}
+
+func QuarantineBecause(reason string) QuarantineOption {
+	return func(q *Quarantine) {
+		q.Enabled = true
+		q.EnabledSet = true
+		q.Reason = reason
+	}
+}
+
+func (q *Quarantine) Copy() Quarantine {
+	return Quarantine{
+		Reason:     q.Reason,
+		Enabled:    q.Enabled,
+		EnabledSet: q.EnabledSet,
+	}
+}
+
+func (q *Quarantine) Join(other Quarantine) Quarantine {
+	result := q.Copy()
+
+	if other.EnabledSet {
+		result.Enabled = other.Enabled
+		result.EnabledSet = true
+	}
+	if other.Reason != "" {
+		result.Reason = other.Reason
+	}
+
+	return result
+}
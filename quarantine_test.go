@@ -0,0 +1,101 @@
+package axiom_test
+
+import (
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewQuarantine_Default(t *testing.T) {
+	q := axiom.NewQuarantine()
+
+	assert.False(t, q.Enabled)
+	assert.False(t, q.EnabledSet)
+	assert.Equal(t, "", q.Reason)
+}
+
+func TestQuarantineBecause(t *testing.T) {
+	q := axiom.NewQuarantine(axiom.QuarantineBecause("JIRA-42"))
+
+	assert.True(t, q.Enabled)
+	assert.True(t, q.EnabledSet)
+	assert.Equal(t, "JIRA-42", q.Reason)
+}
+
+func TestQuarantine_Join(t *testing.T) {
+	base := axiom.NewQuarantine(axiom.QuarantineBecause("runner"))
+
+	inherited := base.Join(axiom.NewQuarantine())
+	assert.True(t, inherited.Enabled)
+	assert.Equal(t, "runner", inherited.Reason)
+
+	disabled := base.Join(axiom.NewQuarantine(axiom.WithQuarantineDisabled()))
+	assert.False(t, disabled.Enabled)
+	assert.True(t, disabled.EnabledSet)
+	assert.Equal(t, "runner", disabled.Reason)
+
+	overridden := base.Join(axiom.NewQuarantine(axiom.WithQuarantineReason("case")))
+	assert.True(t, overridden.Enabled)
+	assert.Equal(t, "case", overridden.Reason)
+}
+
+func TestQuarantine_Copy(t *testing.T) {
+	q := axiom.NewQuarantine(axiom.QuarantineBecause("flaky"))
+
+	assert.Equal(t, q, q.Copy())
+}
+
+func TestConfig_Errorf_QuarantinedDoesNotFail(t *testing.T) {
+	fakeT := &testing.T{}
+	var events []axiom.Event
+
+	cfg := &axiom.Config{
+		SubT:       fakeT,
+		Quarantine: axiom.NewQuarantine(axiom.QuarantineBecause("JIRA-42")),
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			events = append(events, e)
+		})),
+	}
+
+	cfg.Errorf("expected %d, got %d", 1, 2)
+
+	assert.False(t, fakeT.Failed())
+	assert.Equal(t, []string{"expected 1, got 2"}, cfg.QuarantinedFailures())
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, axiom.EventTypeCaseQuarantined, events[0].Type)
+		assert.Equal(t, "JIRA-42", events[0].Name)
+		assert.Equal(t, "expected 1, got 2", events[0].Message)
+	}
+}
+
+func TestConfig_Errorf_FailsWithoutQuarantine(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT}
+
+	cfg.Errorf("boom")
+
+	assert.True(t, fakeT.Failed())
+	assert.Empty(t, cfg.QuarantinedFailures())
+}
+
+func TestConfig_Fatalf_QuarantinedSkips(t *testing.T) {
+	var cfg *axiom.Config
+
+	t.Run("quarantined", func(sub *testing.T) {
+		cfg = &axiom.Config{
+			SubT:       sub,
+			Quarantine: axiom.NewQuarantine(axiom.QuarantineBecause("JIRA-42")),
+		}
+		cfg.Fatalf("setup failed")
+	})
+
+	assert.False(t, t.Failed())
+	assert.Equal(t, []string{"setup failed"}, cfg.QuarantinedFailures())
+}
+
+func TestConfig_Fatalf_PanicsWithoutSubT(t *testing.T) {
+	cfg := &axiom.Config{}
+
+	assert.PanicsWithValue(t, "boom", func() { cfg.Fatalf("boom") })
+}
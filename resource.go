@@ -1,6 +1,7 @@
 package axiom
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 )
@@ -21,6 +22,9 @@ type Resources struct {
 	Registry map[string]Resource
 	Cache    map[string]ResourceResult
 	Cleanups []ResourceCleanup
+	Policies map[string]ResourcePolicy
+	Pools    map[string]*ResourcePool
+	WarmUp   ResourceWarmUp
 }
 
 type resourceOnce struct {
@@ -78,6 +82,19 @@ func (r *Resources) Copy() Resources {
 	if r.Cleanups != nil {
 		result.Cleanups = append([]ResourceCleanup{}, r.Cleanups...)
 	}
+	if r.Policies != nil {
+		result.Policies = make(map[string]ResourcePolicy, len(r.Policies))
+		for k, v := range r.Policies {
+			result.Policies[k] = v
+		}
+	}
+	if r.Pools != nil {
+		result.Pools = make(map[string]*ResourcePool, len(r.Pools))
+		for k, v := range r.Pools {
+			result.Pools[k] = v
+		}
+	}
+	result.WarmUp = r.WarmUp.Copy()
 
 	return result
 }
@@ -106,6 +123,19 @@ func (r *Resources) Join(other Resources) Resources {
 	if len(other.Cleanups) > 0 {
 		result.Cleanups = append(result.Cleanups, other.Cleanups...)
 	}
+	if len(other.Policies) > 0 && result.Policies == nil {
+		result.Policies = make(map[string]ResourcePolicy, len(other.Policies))
+	}
+	for k, v := range other.Policies {
+		result.Policies[k] = v
+	}
+	if len(other.Pools) > 0 && result.Pools == nil {
+		result.Pools = make(map[string]*ResourcePool, len(other.Pools))
+	}
+	for k, v := range other.Pools {
+		result.Pools[k] = v
+	}
+	result.WarmUp = result.WarmUp.Join(other.WarmUp)
 
 	return result
 }
@@ -141,7 +171,7 @@ func GetResource[T any](runner *Runner, name string) (T, error) {
 	if res, ok := runner.Resources.Cache[name]; ok {
 		runner.Resources.mu.Unlock()
 		out, ok := res.Value.(T)
-		if !ok {
+		if !ok && res.Value != nil {
 			return zero, fmt.Errorf("resource %q has unexpected type", name)
 		}
 		return out, nil
@@ -163,10 +193,19 @@ func GetResource[T any](runner *Runner, name string) (T, error) {
 
 	ro.once.Do(func() {
 		runner.Runtime.Event(NewEvent(EventTypeResourceSetupStart, WithEventName(name)))
-		val, cleanup, err := resource(runner)
+		policy := runner.Resources.Policy(name)
+		val, cleanup, err := runner.setupResource(name, resource, policy)
 		if err != nil {
 			ro.err = err
-			runner.Runtime.Event(NewEvent(EventTypeResourceSetupFailed, WithEventName(name), WithEventMessage(err.Error())))
+			runner.Runtime.Event(NewEvent(EventTypeResourceSetupFailed, WithEventName(name), WithEventMessage(errors.Unwrap(err).Error())))
+			if policy.OnFailure == ResourceFailureReset {
+				runner.Resources.mu.Lock()
+				if runner.Resources.onces[name] == ro {
+					delete(runner.Resources.onces, name)
+				}
+				runner.Resources.mu.Unlock()
+				runner.Runtime.Event(NewEvent(EventTypeResourceReset, WithEventName(name)))
+			}
 			return
 		}
 
@@ -184,11 +223,11 @@ func GetResource[T any](runner *Runner, name string) (T, error) {
 	})
 
 	if ro.err != nil {
-		return zero, fmt.Errorf("resource %q failed: %w", name, ro.err)
+		return zero, ro.err
 	}
 
 	out, ok := ro.value.(T)
-	if !ok {
+	if !ok && ro.value != nil {
 		return zero, fmt.Errorf("resource %q has unexpected type", name)
 	}
 	return out, nil
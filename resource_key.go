@@ -0,0 +1,80 @@
+package axiom
+
+import "fmt"
+
+// ResourceKey names a resource together with the type of its value, so the
+// resource is registered and retrieved with the same type.
+type ResourceKey[T any] struct {
+	name string
+}
+
+type TypedResource[T any] func(r *Runner) (T, func(), error)
+
+func NewResourceKey[T any](name string) ResourceKey[T] {
+	if name == "" {
+		panic("resource: key name must not be empty")
+	}
+
+	return ResourceKey[T]{name: name}
+}
+
+func (k ResourceKey[T]) Name() string {
+	return k.name
+}
+
+// Resource adapts rs to the string-keyed Resource registry.
+func (k ResourceKey[T]) Resource(rs TypedResource[T]) Resource {
+	k.validate()
+	if rs == nil {
+		panic(fmt.Sprintf("resource: nil resource for key %q", k.name))
+	}
+
+	return func(r *Runner) (any, func(), error) {
+		return rs(r)
+	}
+}
+
+// Get returns the value of the resource with the semantics of GetResource.
+func (k ResourceKey[T]) Get(r *Runner) (T, error) {
+	k.validate()
+	return GetResource[T](r, k.name)
+}
+
+func (k ResourceKey[T]) Must(r *Runner) T {
+	v, err := k.Get(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return v
+}
+
+func (k ResourceKey[T]) validate() {
+	if k.name == "" {
+		panic("resource: key must be created with NewResourceKey")
+	}
+}
+
+// Require returns the value of the resource with the semantics of
+// RequireResource.
+func (k ResourceKey[T]) Require(cfg *Config) T {
+	k.validate()
+	return RequireResource[T](cfg, k.name)
+}
+
+// HealthCheck adapts check to a ResourceHealthCheck of the value of k.
+func (k ResourceKey[T]) HealthCheck(check func(r *Runner, value T) error) ResourceHealthCheck {
+	k.validate()
+	if check == nil {
+		panic(fmt.Sprintf("resource: nil health check for key %q", k.name))
+	}
+
+	return func(r *Runner, value any) error {
+		v, _ := value.(T)
+		return check(r, v)
+	}
+}
+
+func WithResourceKey[T any](key ResourceKey[T], rs TypedResource[T]) ResourcesOption {
+	return WithResource(key.Name(), key.Resource(rs))
+}
@@ -0,0 +1,73 @@
+package axiom_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type resourceClient struct {
+	URL string
+}
+
+func TestNewResourceKey_EmptyName_Panics(t *testing.T) {
+	assert.PanicsWithValue(t, "resource: key name must not be empty", func() {
+		axiom.NewResourceKey[int]("")
+	})
+}
+
+func TestResourceKey_RegistersAndReturnsTypedValue(t *testing.T) {
+	key := axiom.NewResourceKey[*resourceClient]("client")
+	setups := 0
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourceKey(key, func(r *axiom.Runner) (*resourceClient, func(), error) {
+			setups++
+			return &resourceClient{URL: "http://localhost"}, nil, nil
+		}),
+	)
+
+	client, err := key.Get(r)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost", client.URL)
+	assert.Same(t, client, key.Must(r))
+	assert.Same(t, client, axiom.MustResource[*resourceClient](r, "client"))
+	assert.Equal(t, 1, setups)
+}
+
+func TestResourceKey_WithResourceKey(t *testing.T) {
+	key := axiom.NewResourceKey[string]("dsn")
+	r := &axiom.Runner{Resources: axiom.NewResources(
+		axiom.WithResourceKey(key, func(r *axiom.Runner) (string, func(), error) {
+			return "postgres://", nil, nil
+		}),
+	)}
+
+	assert.Equal(t, "postgres://", key.Must(r))
+}
+
+func TestResourceKey_NilPointerValue_ReturnsZero(t *testing.T) {
+	key := axiom.NewResourceKey[*resourceClient]("client")
+	r := axiom.NewRunner(axiom.WithRunnerResourceKey(key, func(r *axiom.Runner) (*resourceClient, func(), error) {
+		return nil, nil, nil
+	}))
+
+	client, err := key.Get(r)
+
+	require.NoError(t, err)
+	assert.Nil(t, client)
+}
+
+func TestResourceKey_SetupError(t *testing.T) {
+	key := axiom.NewResourceKey[int]("port")
+	r := axiom.NewRunner(axiom.WithRunnerResourceKey(key, func(r *axiom.Runner) (int, func(), error) {
+		return 0, nil, errors.New("boom")
+	}))
+
+	_, err := key.Get(r)
+	assert.EqualError(t, err, `resource "port" failed: boom`)
+	assert.PanicsWithError(t, `resource "port" failed: boom`, func() { key.Must(r) })
+}
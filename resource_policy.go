@@ -0,0 +1,159 @@
+package axiom
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type ResourceFailureMode string
+
+const (
+	// ResourceFailureCache keeps a failed setup for the runner lifetime.
+	ResourceFailureCache ResourceFailureMode = "cache"
+	// ResourceFailureReset discards a failed setup, so the next access sets
+	// the resource up again.
+	ResourceFailureReset ResourceFailureMode = "reset"
+	// ResourceFailureSkip keeps a failed setup and skips cases that require
+	// the resource instead of failing them.
+	ResourceFailureSkip ResourceFailureMode = "skip"
+)
+
+const ResourceAttrAttempt = "resource_attempt"
+
+// ResourcePolicy controls how the setup of a resource is retried and what
+// happens once it has failed. Of Retry only Times, Delay and Backoff apply.
+type ResourcePolicy struct {
+	Retry     Retry
+	OnFailure ResourceFailureMode
+}
+
+type ResourcePolicyOption func(*ResourcePolicy)
+
+func NewResourcePolicy(options ...ResourcePolicyOption) ResourcePolicy {
+	p := ResourcePolicy{}
+	for _, option := range options {
+		option(&p)
+	}
+
+	return p
+}
+
+func WithResourcePolicyRetry(options ...RetryOption) ResourcePolicyOption {
+	return func(p *ResourcePolicy) {
+		r := NewRetry(options...)
+		p.Retry = p.Retry.Join(r)
+	}
+}
+
+func WithResourcePolicyOnFailure(mode ResourceFailureMode) ResourcePolicyOption {
+	return func(p *ResourcePolicy) { p.OnFailure = mode }
+}
+
+func WithResourcePolicy(name string, options ...ResourcePolicyOption) ResourcesOption {
+	return func(r *Resources) {
+		if r.Policies == nil {
+			r.Policies = map[string]ResourcePolicy{}
+		}
+		r.Policies[name] = NewResourcePolicy(options...)
+	}
+}
+
+func (p *ResourcePolicy) Normalize() {
+	p.Retry.Normalize()
+	if p.OnFailure == "" {
+		p.OnFailure = ResourceFailureCache
+	}
+}
+
+func (r *Resources) Policy(name string) ResourcePolicy {
+	policy := r.Policies[name]
+	policy.Retry = policy.Retry.Copy()
+	policy.Normalize()
+
+	return policy
+}
+
+// ResourceSetupError reports a resource whose setup failed after all
+// attempts of its policy.
+type ResourceSetupError struct {
+	Name     string
+	Attempts int
+	Err      error
+}
+
+func (e *ResourceSetupError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("resource %q failed after %d attempts: %v", e.Name, e.Attempts, e.Err)
+	}
+
+	return fmt.Sprintf("resource %q failed: %v", e.Name, e.Err)
+}
+
+func (e *ResourceSetupError) Unwrap() error {
+	return e.Err
+}
+
+// setupResource runs resource until it succeeds or the attempts of policy are
+// exhausted, waiting between attempts as the policy retry delays.
+func (r *Runner) setupResource(name string, resource Resource, policy ResourcePolicy) (any, func(), error) {
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		val, cleanup, err := resource(r)
+		if err == nil {
+			return val, cleanup, nil
+		}
+		if attempt >= policy.Retry.Times {
+			return nil, nil, &ResourceSetupError{Name: name, Attempts: attempt, Err: err}
+		}
+
+		delay = policy.Retry.DelayFor(attempt+1, delay)
+		r.Runtime.Event(NewEvent(
+			EventTypeResourceSetupRetry,
+			WithEventName(name),
+			WithEventMessage(err.Error()),
+			WithEventDuration(delay),
+			WithEventAttrs(NewAttr(ResourceAttrAttempt, attempt)),
+		))
+		time.Sleep(delay)
+	}
+}
+
+// RequireResource returns the resource name for the case of cfg. When the
+// setup of the resource failed and its policy is ResourceFailureSkip, the
+// attempt is skipped with the failure as the reason; otherwise it fails.
+func RequireResource[T any](cfg *Config, name string) T {
+	var zero T
+
+	if cfg == nil {
+		panic("resource: nil config")
+	}
+	if cfg.Runner == nil {
+		panic("resource: config without runner")
+	}
+
+	v, err := GetResource[T](cfg.Runner, name)
+	if err == nil {
+		return v
+	}
+
+	if cfg.Runner.skipsOnFailure(name, err) {
+		reason := fmt.Sprintf("resource %q is unavailable: %v", name, errors.Unwrap(err))
+		cfg.emit(NewEvent(EventTypeResourceUnavailable, WithEventName(name), WithEventMessage(err.Error())))
+		root := cfg.root()
+		root.Skip = root.Skip.Join(NewSkip(SkipBecause(reason)))
+		cfg.T().Skip(reason)
+		return zero
+	}
+
+	cfg.Fatalf("%v", err)
+	return zero
+}
+
+func RequireResources(names ...string) func(cfg *Config) {
+	return func(cfg *Config) {
+		for _, name := range names {
+			RequireResource[any](cfg, name)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package axiom_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func failingResource(failures int, calls *int) axiom.Resource {
+	return func(r *axiom.Runner) (any, func(), error) {
+		*calls++
+		if *calls <= failures {
+			return nil, nil, errors.New("connection refused")
+		}
+		return "ready", nil, nil
+	}
+}
+
+func TestGetResource_PolicyRetriesSetup(t *testing.T) {
+	var calls int
+	var retries []axiom.Event
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", failingResource(2, &calls)),
+		axiom.WithRunnerResourcePolicy("db", axiom.WithResourcePolicyRetry(
+			axiom.WithRetryTimes(3),
+			axiom.WithRetryDelay(time.Millisecond),
+		)),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceSetupRetry {
+				retries = append(retries, e)
+			}
+		})),
+	)
+
+	value, err := axiom.GetResource[string](r, "db")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ready", value)
+	assert.Equal(t, 3, calls)
+	require.Len(t, retries, 2)
+	assert.Equal(t, "connection refused", retries[0].Message)
+	assert.Equal(t, time.Millisecond, retries[0].Duration)
+	assert.Equal(t, []axiom.Attr{axiom.NewAttr(axiom.ResourceAttrAttempt, 2)}, retries[1].Attrs)
+}
+
+func TestGetResource_PolicyExhausted_CachesFailure(t *testing.T) {
+	var calls int
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", failingResource(5, &calls)),
+		axiom.WithRunnerResourcePolicy("db", axiom.WithResourcePolicyRetry(axiom.WithRetryTimes(2))),
+	)
+
+	_, err := axiom.GetResource[string](r, "db")
+	_, again := axiom.GetResource[string](r, "db")
+
+	var setupErr *axiom.ResourceSetupError
+	require.ErrorAs(t, err, &setupErr)
+	assert.Equal(t, 2, setupErr.Attempts)
+	assert.EqualError(t, err, `resource "db" failed after 2 attempts: connection refused`)
+	assert.Same(t, err, again)
+	assert.Equal(t, 2, calls)
+}
+
+func TestGetResource_ResetPolicy_SetsUpAgainAfterFailure(t *testing.T) {
+	var calls int
+	var resets int
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", failingResource(1, &calls)),
+		axiom.WithRunnerResourcePolicy("db", axiom.WithResourcePolicyOnFailure(axiom.ResourceFailureReset)),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceReset {
+				resets++
+			}
+		})),
+	)
+
+	_, err := axiom.GetResource[string](r, "db")
+	require.Error(t, err)
+
+	value, err := axiom.GetResource[string](r, "db")
+	require.NoError(t, err)
+	assert.Equal(t, "ready", value)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 1, resets)
+}
+
+func TestRequireResource_SkipPolicy_SkipsCase(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	var skipped []string
+	var unavailable []string
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", failingResource(1, &calls)),
+		axiom.WithRunnerResourcePolicy("db", axiom.WithResourcePolicyOnFailure(axiom.ResourceFailureSkip)),
+		axiom.WithRunnerHooks(axiom.WithBeforeTest(axiom.RequireResources("db"))),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			switch e.Type {
+			case axiom.EventTypeCaseSkipped:
+				skipped = append(skipped, e.Message)
+			case axiom.EventTypeResourceUnavailable:
+				unavailable = append(unavailable, e.Name)
+			}
+		})),
+	)
+
+	ran := 0
+	for range 2 {
+		r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) { ran++ })
+	}
+
+	assert.Equal(t, 0, ran)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, []string{"db", "db"}, unavailable)
+	assert.Equal(t, []string{
+		`resource "db" is unavailable: connection refused`,
+		`resource "db" is unavailable: connection refused`,
+	}, skipped)
+}
+
+func TestRequireResource_DefaultPolicy_FailsCase(t *testing.T) {
+	var calls int
+	r := axiom.NewRunner(axiom.WithRunnerResource("db", failingResource(1, &calls)))
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT, Runner: r}
+
+	runFixtureFatal(func() { _ = axiom.RequireResource[string](cfg, "db") })
+
+	assert.True(t, fakeT.Failed())
+	assert.False(t, fakeT.Skipped())
+	assert.Equal(t, []string{`resource "db" failed: connection refused`}, cfg.Failures())
+}
+
+func TestResourceKey_Require(t *testing.T) {
+	key := axiom.NewResourceKey[int]("port")
+	r := axiom.NewRunner(axiom.WithRunnerResourceKey(key, func(r *axiom.Runner) (int, func(), error) {
+		return 8080, nil, nil
+	}))
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		assert.Equal(t, 8080, key.Require(cfg))
+	})
+}
+
+func TestWarmUpResources_SkipPolicyDoesNotAbort(t *testing.T) {
+	var calls int
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", failingResource(1, &calls)),
+		axiom.WithRunnerResourcePolicy("db", axiom.WithResourcePolicyOnFailure(axiom.ResourceFailureSkip)),
+		axiom.WithRunnerResourceWarmUp("db"),
+	)
+
+	assert.NoError(t, r.WarmUpResources())
+	_, err := axiom.GetResource[string](r, "db")
+	assert.Error(t, err)
+}
+
+func TestResources_JoinMergesPolicies(t *testing.T) {
+	base := axiom.NewResources(
+		axiom.WithResourcePolicy("db", axiom.WithResourcePolicyOnFailure(axiom.ResourceFailureSkip)),
+	)
+	other := axiom.NewResources(
+		axiom.WithResourcePolicy("cache", axiom.WithResourcePolicyRetry(axiom.WithRetryTimes(3))),
+	)
+
+	joined := base.Join(other)
+
+	assert.Equal(t, axiom.ResourceFailureSkip, joined.Policy("db").OnFailure)
+	assert.Equal(t, 3, joined.Policy("cache").Retry.Times)
+	assert.Equal(t, axiom.ResourceFailureCache, joined.Policy("queue").OnFailure)
+	assert.Equal(t, 1, joined.Policy("queue").Retry.Times)
+	assert.NotContains(t, base.Policies, "cache")
+}
@@ -0,0 +1,200 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const ResourceAttrInstance = "resource_instance"
+
+// ResourcePool holds interchangeable instances that are leased to one case at
+// a time. Copies of Resources share the same pool.
+type ResourcePool struct {
+	name      string
+	instances []any
+	free      chan int
+}
+
+type ResourceLease struct {
+	Index int
+	Value any
+}
+
+func NewResourcePool(name string, instances ...any) *ResourcePool {
+	if len(instances) == 0 {
+		panic(fmt.Sprintf("resource: pool %q has no instances", name))
+	}
+
+	free := make(chan int, len(instances))
+	for i := range instances {
+		free <- i
+	}
+
+	return &ResourcePool{name: name, instances: instances, free: free}
+}
+
+func WithResourcePool(name string, instances ...any) ResourcesOption {
+	return func(r *Resources) {
+		if r.Pools == nil {
+			r.Pools = map[string]*ResourcePool{}
+		}
+		r.Pools[name] = NewResourcePool(name, instances...)
+	}
+}
+
+func (p *ResourcePool) Name() string {
+	return p.name
+}
+
+func (p *ResourcePool) Size() int {
+	return len(p.instances)
+}
+
+func (p *ResourcePool) Available() int {
+	return len(p.free)
+}
+
+// Acquire blocks until an instance is free or ctx is done.
+func (p *ResourcePool) Acquire(ctx context.Context) (ResourceLease, error) {
+	select {
+	case i := <-p.free:
+		return ResourceLease{Index: i, Value: p.instances[i]}, nil
+	case <-ctx.Done():
+		return ResourceLease{}, context.Cause(ctx)
+	}
+}
+
+func (p *ResourcePool) Release(lease ResourceLease) {
+	select {
+	case p.free <- lease.Index:
+	default:
+		panic(fmt.Sprintf("resource: pool %q released more instances than it holds", p.name))
+	}
+}
+
+// LeaseResource leases an instance of the pool name for the current attempt,
+// blocking until one is free. The instance is released in Fixtures.Teardown;
+// repeated calls within an attempt return the same instance.
+func LeaseResource[T any](cfg *Config, name string) T {
+	return LeaseResourceWithin[T](cfg, name, 0)
+}
+
+// LeaseResourceWithin is LeaseResource that fails the attempt when no
+// instance becomes free within timeout. A zero timeout waits indefinitely.
+func LeaseResourceWithin[T any](cfg *Config, name string, timeout time.Duration) T {
+	var zero T
+
+	if cfg == nil {
+		panic("resource: nil config")
+	}
+	if cfg.Runner == nil {
+		panic("resource: config without runner")
+	}
+
+	pool, found := cfg.Runner.Resources.Pools[name]
+	if !found {
+		cfg.Fatalf("resource pool %q not found", name)
+		return zero
+	}
+
+	lease, err := cfg.lease(pool, timeout)
+	if err != nil {
+		cfg.Fatalf("resource pool %q: %v", name, err)
+		return zero
+	}
+
+	out, ok := lease.Value.(T)
+	if !ok && lease.Value != nil {
+		cfg.Fatalf("resource pool %q has unexpected type", name)
+		return zero
+	}
+
+	return out
+}
+
+type leaseCall struct {
+	done  chan struct{}
+	lease ResourceLease
+	err   error
+}
+
+// lease returns the lease of pool held by the attempt of c. Goroutines of an
+// attempt share a single lease: a goroutine requesting pool while another one
+// waits for an instance waits for the same acquire instead of taking a second
+// instance. Other pools are not blocked meanwhile.
+func (c *Config) lease(pool *ResourcePool, timeout time.Duration) (ResourceLease, error) {
+	root := c.root()
+	root.leaseMu.Lock()
+	if lease, ok := root.leases[pool.name]; ok {
+		root.leaseMu.Unlock()
+		return lease, nil
+	}
+	if call, ok := root.leaseCalls[pool.name]; ok {
+		root.leaseMu.Unlock()
+		<-call.done
+		return call.lease, call.err
+	}
+
+	call := &leaseCall{done: make(chan struct{})}
+	if root.leaseCalls == nil {
+		root.leaseCalls = map[string]*leaseCall{}
+	}
+	root.leaseCalls[pool.name] = call
+	root.leaseMu.Unlock()
+
+	call.lease, call.err = c.acquireLease(pool, timeout)
+
+	root.leaseMu.Lock()
+	delete(root.leaseCalls, pool.name)
+	if call.err == nil {
+		if root.leases == nil {
+			root.leases = map[string]ResourceLease{}
+		}
+		root.leases[pool.name] = call.lease
+	}
+	root.leaseMu.Unlock()
+	close(call.done)
+
+	return call.lease, call.err
+}
+
+func (c *Config) acquireLease(pool *ResourcePool, timeout time.Duration) (ResourceLease, error) {
+	ctx := c.Context.Raw
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout, fmt.Errorf("no instance available within %s", timeout))
+		defer cancel()
+	}
+
+	start := time.Now()
+	lease, err := pool.Acquire(ctx)
+	acquired := time.Now()
+	waited := acquired.Sub(start)
+	if err != nil {
+		c.emit(NewEvent(EventTypeResourceLeaseTimeout, WithEventName(pool.name), WithEventMessage(err.Error()), WithEventDuration(waited)))
+		return ResourceLease{}, err
+	}
+
+	instance := WithEventAttrs(NewAttr(ResourceAttrInstance, lease.Index))
+	c.emit(NewEvent(EventTypeResourceLeaseAcquired, WithEventName(pool.name), WithEventDuration(waited), instance))
+
+	// Factories of shared-scope fixtures run with a Config owned by the scope,
+	// so their leases are released when the scope is torn down.
+	root := c.root()
+	root.fixtureMu.Lock()
+	defer root.fixtureMu.Unlock()
+	root.Fixtures.Cleanups = append(root.Fixtures.Cleanups, func(c *Config) {
+		c.leaseMu.Lock()
+		delete(c.leases, pool.name)
+		c.leaseMu.Unlock()
+
+		pool.Release(lease)
+		c.emit(NewEvent(EventTypeResourceLeaseReleased, WithEventName(pool.name), WithEventDuration(time.Since(acquired)), instance))
+	})
+
+	return lease, nil
+}
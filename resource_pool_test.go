@@ -0,0 +1,260 @@
+package axiom_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResourcePool_NoInstances_Panics(t *testing.T) {
+	assert.PanicsWithValue(t, `resource: pool "tenant" has no instances`, func() {
+		axiom.NewResourcePool("tenant")
+	})
+}
+
+func TestResourcePool_AcquireAndRelease(t *testing.T) {
+	pool := axiom.NewResourcePool("tenant", "a", "b")
+
+	first, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+	second, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []any{"a", "b"}, []any{first.Value, second.Value})
+	assert.Equal(t, 0, pool.Available())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pool.Acquire(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	pool.Release(first)
+	assert.Equal(t, 1, pool.Available())
+	assert.Equal(t, 2, pool.Size())
+}
+
+func TestLeaseResource_ParallelCasesGetExclusiveInstances(t *testing.T) {
+	var inUse, maxInUse atomic.Int64
+	var mu sync.Mutex
+	held := map[string]bool{}
+
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourcePool("tenant", "tenant-1", "tenant-2"),
+		axiom.WithRunnerParallel(axiom.WithParallelEnabled()),
+	)
+
+	t.Run("cases", func(t *testing.T) {
+		for i := range 4 {
+			r.RunCase(t, axiom.NewCase(axiom.WithCaseName(fmt.Sprintf("case-%d", i))), func(cfg *axiom.Config) {
+				tenant := axiom.LeaseResource[string](cfg, "tenant")
+				assert.Equal(t, tenant, axiom.LeaseResource[string](cfg, "tenant"))
+
+				mu.Lock()
+				assert.False(t, held[tenant], "tenant %s leased twice", tenant)
+				held[tenant] = true
+				mu.Unlock()
+
+				maxInUse.Store(max(maxInUse.Load(), inUse.Add(1)))
+				time.Sleep(10 * time.Millisecond)
+				inUse.Add(-1)
+
+				mu.Lock()
+				held[tenant] = false
+				mu.Unlock()
+			})
+		}
+	})
+
+	assert.LessOrEqual(t, maxInUse.Load(), int64(2))
+	assert.Equal(t, 2, r.Resources.Pools["tenant"].Available())
+}
+
+func TestLeaseResource_ReleasedInFixturesTeardown(t *testing.T) {
+	var events []axiom.Event
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourcePool("port", 8080),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			switch e.Type {
+			case axiom.EventTypeResourceLeaseAcquired, axiom.EventTypeResourceLeaseReleased:
+				events = append(events, e)
+			}
+		})),
+	)
+	pool := r.Resources.Pools["port"]
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		assert.Equal(t, 8080, axiom.LeaseResource[int](cfg, "port"))
+		assert.Equal(t, 0, pool.Available())
+	})
+
+	assert.Equal(t, 1, pool.Available())
+	require.Len(t, events, 2)
+	assert.Equal(t, axiom.EventTypeResourceLeaseAcquired, events[0].Type)
+	assert.Equal(t, "port", events[0].Name)
+	assert.Equal(t, []axiom.Attr{axiom.NewAttr(axiom.ResourceAttrInstance, 0)}, events[0].Attrs)
+	assert.Equal(t, axiom.EventTypeResourceLeaseReleased, events[1].Type)
+}
+
+func TestLeaseResource_ReportsWaitTime(t *testing.T) {
+	var waited time.Duration
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourcePool("tenant", "tenant-1"),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceLeaseAcquired {
+				waited = e.Duration
+			}
+		})),
+	)
+	pool := r.Resources.Pools["tenant"]
+	lease, err := pool.Acquire(context.Background())
+	require.NoError(t, err)
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		// The lease is requested right after the release is scheduled, well
+		// within the 10ms margin.
+		go func() {
+			time.Sleep(30 * time.Millisecond)
+			pool.Release(lease)
+		}()
+		axiom.LeaseResource[string](cfg, "tenant")
+	})
+
+	assert.GreaterOrEqual(t, waited, 20*time.Millisecond)
+}
+
+func TestLeaseResourceWithin_Timeout_FailsAttempt(t *testing.T) {
+	var timeouts []axiom.Event
+	r := axiom.NewRunner(axiom.WithRunnerResourcePool("tenant", "tenant-1"))
+	_, err := r.Resources.Pools["tenant"].Acquire(context.Background())
+	require.NoError(t, err)
+
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT:   fakeT,
+		Runner: r,
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceLeaseTimeout {
+				timeouts = append(timeouts, e)
+			}
+		})),
+	}
+
+	runFixtureFatal(func() { _ = axiom.LeaseResourceWithin[string](cfg, "tenant", 10*time.Millisecond) })
+
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{`resource pool "tenant": no instance available within 10ms`}, cfg.Failures())
+	require.Len(t, timeouts, 1)
+	assert.Equal(t, "no instance available within 10ms", timeouts[0].Message)
+}
+
+func TestLeaseResource_UnknownPool_FailsAttempt(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT, Runner: axiom.NewRunner()}
+
+	runFixtureFatal(func() { _ = axiom.LeaseResource[string](cfg, "tenant") })
+
+	assert.Equal(t, []string{`resource pool "tenant" not found`}, cfg.Failures())
+}
+
+func TestResources_JoinSharesPools(t *testing.T) {
+	base := axiom.NewResources(axiom.WithResourcePool("tenant", "a"))
+	other := axiom.NewResources(axiom.WithResourcePool("port", 8080))
+
+	joined := base.Join(other)
+
+	assert.Same(t, base.Pools["tenant"], joined.Pools["tenant"])
+	assert.Same(t, other.Pools["port"], joined.Pools["port"])
+	assert.NotContains(t, base.Pools, "port")
+}
+
+func TestLeaseResource_ConcurrentLeasesWithinAttemptShareInstance(t *testing.T) {
+	r := axiom.NewRunner(axiom.WithRunnerResourcePool("tenant", "tenant-1", "tenant-2"))
+	pool := r.Resources.Pools["tenant"]
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		tenants := make([]string, 8)
+		var wg sync.WaitGroup
+		for i := range tenants {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				tenants[i] = axiom.LeaseResource[string](cfg, "tenant")
+			}()
+		}
+		wg.Wait()
+
+		for _, tenant := range tenants {
+			assert.Equal(t, tenants[0], tenant)
+		}
+		assert.Equal(t, 1, pool.Available())
+	})
+
+	assert.Equal(t, 2, pool.Available())
+}
+
+func TestLeaseResource_WaitingForPoolDoesNotBlockOtherPools(t *testing.T) {
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourcePool("tenant", "tenant-1"),
+		axiom.WithRunnerResourcePool("port", 8080),
+	)
+	tenants := r.Resources.Pools["tenant"]
+	lease, err := tenants.Acquire(context.Background())
+	require.NoError(t, err)
+
+	r.RunCase(t, axiom.NewCase(), func(cfg *axiom.Config) {
+		leased := make(chan string)
+		go func() { leased <- axiom.LeaseResource[string](cfg, "tenant") }()
+		// Gives the goroutine time to block on the exhausted tenant pool.
+		time.Sleep(10 * time.Millisecond)
+
+		port := make(chan int)
+		go func() { port <- axiom.LeaseResource[int](cfg, "port") }()
+		select {
+		case p := <-port:
+			assert.Equal(t, 8080, p)
+		case <-time.After(time.Second):
+			t.Fatal("leasing port waited for the tenant pool")
+		}
+
+		tenants.Release(lease)
+		assert.Equal(t, "tenant-1", <-leased)
+	})
+}
+
+func TestLeaseResource_FromSuiteScopedFixture_ReleasedWithScope(t *testing.T) {
+	var released atomic.Int64
+	r := axiom.NewRunner(
+		axiom.WithRunnerResourcePool("tenant", "tenant-1"),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceLeaseReleased {
+				released.Add(1)
+			}
+		})),
+		axiom.WithRunnerFixture("account", func(cfg *axiom.Config) (any, func(), error) {
+			return "account@" + axiom.LeaseResource[string](cfg, "tenant"), nil, nil
+		}),
+		axiom.WithRunnerFixtureScope("account", axiom.FixtureScopeSuite),
+	)
+	pool := r.Resources.Pools["tenant"]
+
+	t.Run("suite", func(t *testing.T) {
+		for _, name := range []string{"first", "second"} {
+			r.RunCase(t, axiom.NewCase(axiom.WithCaseName(name)), func(cfg *axiom.Config) {
+				assert.Equal(t, "account@tenant-1", axiom.GetFixture[string](cfg, "account"))
+			})
+
+			assert.Equal(t, 0, pool.Available(), "lease of %s case is held by the suite", name)
+			assert.Zero(t, released.Load())
+		}
+	})
+
+	assert.Equal(t, 1, pool.Available())
+	assert.Equal(t, int64(1), released.Load())
+}
@@ -0,0 +1,214 @@
+package axiom
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type ResourceHealthCheck func(r *Runner, value any) error
+
+// ResourceWarmUp selects resources that are set up eagerly and concurrently
+// when the runner starts instead of on first GetResource.
+type ResourceWarmUp struct {
+	Names   []string
+	Timeout time.Duration
+	Checks  map[string]ResourceHealthCheck
+}
+
+type ResourceWarmUpFailure struct {
+	Name string
+	Err  error
+}
+
+// ResourceWarmUpError reports every resource that failed to warm up.
+type ResourceWarmUpError struct {
+	Total    int
+	Failures []ResourceWarmUpFailure
+}
+
+func (e *ResourceWarmUpError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "resource warm-up failed for %d of %d resource(s):", len(e.Failures), e.Total)
+	for _, failure := range e.Failures {
+		fmt.Fprintf(&b, "\n  - %s: %v", failure.Name, failure.Err)
+	}
+
+	return b.String()
+}
+
+func (e *ResourceWarmUpError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, failure := range e.Failures {
+		errs = append(errs, failure.Err)
+	}
+
+	return errs
+}
+
+func WithResourceWarmUp(names ...string) ResourcesOption {
+	return func(r *Resources) {
+		for _, name := range names {
+			if !slices.Contains(r.WarmUp.Names, name) {
+				r.WarmUp.Names = append(r.WarmUp.Names, name)
+			}
+		}
+	}
+}
+
+func WithResourceWarmUpTimeout(timeout time.Duration) ResourcesOption {
+	return func(r *Resources) { r.WarmUp.Timeout = timeout }
+}
+
+// WithResourceHealthCheck registers a check that runs on the value of name
+// after it is warmed up.
+func WithResourceHealthCheck(name string, check ResourceHealthCheck) ResourcesOption {
+	return func(r *Resources) {
+		if r.WarmUp.Checks == nil {
+			r.WarmUp.Checks = map[string]ResourceHealthCheck{}
+		}
+		r.WarmUp.Checks[name] = check
+	}
+}
+
+func (w *ResourceWarmUp) Copy() ResourceWarmUp {
+	result := ResourceWarmUp{Timeout: w.Timeout}
+
+	if w.Names != nil {
+		result.Names = append([]string{}, w.Names...)
+	}
+	if w.Checks != nil {
+		result.Checks = make(map[string]ResourceHealthCheck, len(w.Checks))
+		for k, v := range w.Checks {
+			result.Checks[k] = v
+		}
+	}
+
+	return result
+}
+
+func (w *ResourceWarmUp) Join(other ResourceWarmUp) ResourceWarmUp {
+	result := w.Copy()
+
+	for _, name := range other.Names {
+		if !slices.Contains(result.Names, name) {
+			result.Names = append(result.Names, name)
+		}
+	}
+	if other.Timeout > 0 {
+		result.Timeout = other.Timeout
+	}
+	if len(other.Checks) > 0 && result.Checks == nil {
+		result.Checks = map[string]ResourceHealthCheck{}
+	}
+	for k, v := range other.Checks {
+		result.Checks[k] = v
+	}
+
+	return result
+}
+
+// WarmUpResources sets up the warm-up resources concurrently and runs their
+// health checks. Resources still starting when the timeout expires are
+// reported as failed without waiting for them.
+func (r *Runner) WarmUpResources() error {
+	warmUp := r.Resources.WarmUp
+	if len(warmUp.Names) == 0 {
+		return nil
+	}
+
+	start := time.Now()
+	r.Runtime.Event(NewEvent(EventTypeResourceWarmUpStart, WithEventMessage(strings.Join(warmUp.Names, ", "))))
+
+	type result struct {
+		index int
+		err   error
+	}
+
+	// Results are only read from done, so resources still starting after the
+	// timeout never write to errs.
+	errs := make([]error, len(warmUp.Names))
+	finished := make([]bool, len(warmUp.Names))
+	done := make(chan result, len(warmUp.Names))
+	for i, name := range warmUp.Names {
+		go func() {
+			done <- result{index: i, err: r.warmUpResource(name, warmUp.Checks[name])}
+		}()
+	}
+
+	var timeout <-chan time.Time
+	if warmUp.Timeout > 0 {
+		timer := time.NewTimer(warmUp.Timeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+wait:
+	for pending := len(warmUp.Names); pending > 0; pending-- {
+		select {
+		case res := <-done:
+			errs[res.index], finished[res.index] = res.err, true
+		case <-timeout:
+			break wait
+		}
+	}
+
+	report := &ResourceWarmUpError{Total: len(warmUp.Names)}
+	for i, name := range warmUp.Names {
+		err := errs[i]
+		if !finished[i] {
+			err = fmt.Errorf("not ready within %s", warmUp.Timeout)
+		}
+		if err == nil {
+			continue
+		}
+
+		r.Runtime.Event(NewEvent(EventTypeResourceWarmUpFailed, WithEventName(name), WithEventMessage(err.Error())))
+		if r.skipsOnFailure(name, err) {
+			continue
+		}
+		report.Failures = append(report.Failures, ResourceWarmUpFailure{Name: name, Err: err})
+	}
+
+	r.Runtime.Event(NewEvent(EventTypeResourceWarmUpFinish, WithEventDuration(time.Since(start))))
+	if len(report.Failures) > 0 {
+		return report
+	}
+
+	return nil
+}
+
+func (r *Runner) warmUpResource(name string, check ResourceHealthCheck) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("panic: %v", v)
+		}
+	}()
+
+	value, err := GetResource[any](r, name)
+	if err != nil {
+		return err
+	}
+	if check == nil {
+		return nil
+	}
+	if err := check(r, value); err != nil {
+		return fmt.Errorf("health check: %w", err)
+	}
+
+	return nil
+}
+
+// skipsOnFailure reports whether the failed setup of name is left to the
+// cases requiring it instead of aborting the warm-up.
+func (r *Runner) skipsOnFailure(name string, err error) bool {
+	var setupErr *ResourceSetupError
+	return errors.As(err, &setupErr) && r.Resources.Policy(name).OnFailure == ResourceFailureSkip
+}
+
+// WarmUpErr returns the warm-up failure of ApplyStart, if any.
+func (r *Runner) WarmUpErr() error {
+	return r.warmUpErr
+}
@@ -0,0 +1,186 @@
+package axiom_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarmUpResources_SetsUpResourcesConcurrently(t *testing.T) {
+	var started sync.WaitGroup
+	started.Add(2)
+	barrier := func(r *axiom.Runner) (any, func(), error) {
+		started.Done()
+		started.Wait()
+		return "ready", nil, nil
+	}
+
+	var mu sync.Mutex
+	var events []axiom.EventType
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", barrier),
+		axiom.WithRunnerResource("cache", barrier),
+		axiom.WithRunnerResourceWarmUp("db", "cache"),
+		axiom.WithRunnerResourceWarmUpTimeout(time.Second),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			mu.Lock()
+			defer mu.Unlock()
+			if e.Type == axiom.EventTypeResourceWarmUpStart || e.Type == axiom.EventTypeResourceWarmUpFinish {
+				events = append(events, e.Type)
+			}
+		})),
+	)
+
+	require.NoError(t, r.WarmUpResources())
+	assert.Equal(t, "ready", axiom.MustResource[string](r, "db"))
+	assert.Equal(t, []axiom.EventType{axiom.EventTypeResourceWarmUpStart, axiom.EventTypeResourceWarmUpFinish}, events)
+}
+
+func TestWarmUpResources_ReportsEveryUnhealthyResource(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	key := axiom.NewResourceKey[int]("port")
+	var failed []string
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", func(r *axiom.Runner) (any, func(), error) {
+			return nil, nil, errors.New("connection refused")
+		}),
+		axiom.WithRunnerResourceKey(key, func(r *axiom.Runner) (int, func(), error) {
+			return 0, nil, nil
+		}),
+		axiom.WithRunnerResourceHealthCheck("port", key.HealthCheck(func(r *axiom.Runner, port int) error {
+			if port == 0 {
+				return errors.New("port is not bound")
+			}
+			return nil
+		})),
+		axiom.WithRunnerResource("queue", func(r *axiom.Runner) (any, func(), error) {
+			<-release
+			return "queue", nil, nil
+		}),
+		axiom.WithRunnerResource("cache", func(r *axiom.Runner) (any, func(), error) {
+			return "cache", nil, nil
+		}),
+		axiom.WithRunnerResourceWarmUp("db", "port", "queue", "cache"),
+		axiom.WithRunnerResourceWarmUpTimeout(50*time.Millisecond),
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeResourceWarmUpFailed {
+				failed = append(failed, e.Name)
+			}
+		})),
+	)
+
+	err := r.WarmUpResources()
+
+	var report *axiom.ResourceWarmUpError
+	require.ErrorAs(t, err, &report)
+	assert.Equal(t, "resource warm-up failed for 3 of 4 resource(s):\n"+
+		"  - db: resource \"db\" failed: connection refused\n"+
+		"  - port: health check: port is not bound\n"+
+		"  - queue: not ready within 50ms", err.Error())
+	assert.Equal(t, []string{"db", "port", "queue"}, failed)
+}
+
+func TestWarmUpResources_ResourceFinishingAfterTimeoutIsNotRead(t *testing.T) {
+	returned := make(chan struct{})
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", func(r *axiom.Runner) (any, func(), error) {
+			return "db", nil, nil
+		}),
+		axiom.WithRunnerResourceHealthCheck("db", func(r *axiom.Runner, value any) error {
+			defer close(returned)
+			time.Sleep(30 * time.Millisecond)
+			return errors.New("connection refused")
+		}),
+		axiom.WithRunnerResourceWarmUp("db"),
+		axiom.WithRunnerResourceWarmUpTimeout(10*time.Millisecond),
+	)
+
+	err := r.WarmUpResources()
+	<-returned
+	time.Sleep(10 * time.Millisecond)
+
+	assert.EqualError(t, err, "resource warm-up failed for 1 of 1 resource(s):\n  - db: not ready within 10ms")
+}
+
+func TestWarmUpResources_RecoversPanics(t *testing.T) {
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", func(r *axiom.Runner) (any, func(), error) {
+			panic("boom")
+		}),
+		axiom.WithRunnerResourceWarmUp("db"),
+	)
+
+	assert.EqualError(t, r.WarmUpResources(), "resource warm-up failed for 1 of 1 resource(s):\n  - db: panic: boom")
+}
+
+func TestRunPackageWith_WarmUpFailure_AbortsPackage(t *testing.T) {
+	var order []string
+	r := axiom.NewRunner(
+		axiom.WithRunnerHooks(
+			axiom.WithBeforeAll(func(_ *axiom.Runner) { order = append(order, "before-all") }),
+			axiom.WithAfterAll(func(_ *axiom.Runner) { order = append(order, "after-all") }),
+		),
+		axiom.WithRunnerResource("db", func(r *axiom.Runner) (any, func(), error) {
+			order = append(order, "db-setup")
+			return "db", func() { order = append(order, "db-cleanup") }, nil
+		}),
+		axiom.WithRunnerResource("cache", func(r *axiom.Runner) (any, func(), error) {
+			return nil, nil, errors.New("unavailable")
+		}),
+		axiom.WithRunnerResourceWarmUp("db"),
+		axiom.WithRunnerResourceWarmUp("cache"),
+	)
+
+	code := axiom.RunPackageWith(r, func() int {
+		order = append(order, "entry")
+		return 0
+	})
+
+	assert.Equal(t, 1, code)
+	assert.Equal(t, []string{"before-all", "db-setup", "after-all", "db-cleanup"}, order)
+}
+
+func TestRunCase_WarmUpFailure_FailsCase(t *testing.T) {
+	r := axiom.NewRunner(
+		axiom.WithRunnerResource("db", func(r *axiom.Runner) (any, func(), error) {
+			return nil, nil, errors.New("unavailable")
+		}),
+		axiom.WithRunnerResourceWarmUp("db"),
+	)
+	fakeT := &testing.T{}
+	ran := false
+
+	runFixtureFatal(func() {
+		r.RunCase(fakeT, axiom.NewCase(), func(cfg *axiom.Config) { ran = true })
+	})
+
+	assert.True(t, fakeT.Failed())
+	assert.False(t, ran)
+	assert.Error(t, r.WarmUpErr())
+}
+
+func TestResources_JoinMergesWarmUp(t *testing.T) {
+	check := func(r *axiom.Runner, value any) error { return nil }
+	base := axiom.NewResources(
+		axiom.WithResourceWarmUp("db"),
+		axiom.WithResourceWarmUpTimeout(time.Second),
+	)
+	other := axiom.NewResources(
+		axiom.WithResourceWarmUp("db", "cache"),
+		axiom.WithResourceHealthCheck("cache", check),
+	)
+
+	joined := base.Join(other)
+
+	assert.Equal(t, []string{"db", "cache"}, joined.WarmUp.Names)
+	assert.Equal(t, time.Second, joined.WarmUp.Timeout)
+	assert.Contains(t, joined.WarmUp.Checks, "cache")
+	assert.Equal(t, []string{"db"}, base.WarmUp.Names)
+}
@@ -1,17 +1,31 @@
 package axiom
 
 import (
+	"math/rand/v2"
+	"strings"
 	"time"
 )
 
+type RetryBackoff func(attempt int, delay, previous time.Duration) time.Duration
+type RetryPredicate func(a RetryAttempt) bool
+
 type Retry struct {
-	Times int
-	Delay time.Duration
+	Times   int
+	Delay   time.Duration
+	Backoff RetryBackoff
+	RetryOn RetryPredicate
 
 	TimesSet bool
 	DelaySet bool
 }
 
+type RetryAttempt struct {
+	Number  int
+	Logs    []Log
+	Events  []Event
+	Asserts []Assert
+}
+
 type RetryOption func(*Retry)
 
 func NewRetry(options ...RetryOption) Retry {
@@ -37,10 +51,119 @@ func WithRetryDelay(delay time.Duration) RetryOption {
 	}
 }
 
+func WithRetryBackoff(backoff RetryBackoff) RetryOption {
+	return func(r *Retry) { r.Backoff = backoff }
+}
+
+func WithRetryOn(predicate RetryPredicate) RetryOption {
+	return func(r *Retry) { r.RetryOn = predicate }
+}
+
+func NewConstantBackoff() RetryBackoff {
+	return func(_ int, delay, _ time.Duration) time.Duration {
+		return delay
+	}
+}
+
+func NewLinearBackoff(max time.Duration) RetryBackoff {
+	return func(attempt int, delay, _ time.Duration) time.Duration {
+		return capBackoff(delay*time.Duration(attempt-1), max)
+	}
+}
+
+func NewExponentialBackoff(max time.Duration) RetryBackoff {
+	return func(attempt int, delay, _ time.Duration) time.Duration {
+		result := delay
+		for i := 2; i < attempt; i++ {
+			result *= 2
+			if max > 0 && result >= max {
+				return max
+			}
+		}
+
+		return capBackoff(result, max)
+	}
+}
+
+// NewDecorrelatedJitterBackoff picks a random delay between the base delay and
+// three times the previous one, which spreads retries of concurrent cases apart.
+func NewDecorrelatedJitterBackoff(max time.Duration) RetryBackoff {
+	return func(_ int, delay, previous time.Duration) time.Duration {
+		if delay <= 0 {
+			return 0
+		}
+		if previous < delay {
+			previous = delay
+		}
+
+		upper := previous * 3
+		if max > 0 && upper > max {
+			upper = max
+		}
+		if upper <= delay {
+			return capBackoff(delay, max)
+		}
+
+		return delay + rand.N(upper-delay)
+	}
+}
+
+func RetryOnMessage(substrings ...string) RetryPredicate {
+	return func(a RetryAttempt) bool {
+		for _, substring := range substrings {
+			if a.Contains(substring) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Contains reports whether substring appears in a failure of the attempt:
+// the message of a failure or panic event, or the message, error or failure
+// of a failed assertion. Logs and passed assertions are not considered.
+func (a RetryAttempt) Contains(substring string) bool {
+	for _, e := range a.Events {
+		if retryFailureEvents[e.Type] && strings.Contains(e.Message, substring) {
+			return true
+		}
+	}
+	for _, as := range a.Asserts {
+		if as.Result == nil || as.Result.Passed {
+			continue
+		}
+		if strings.Contains(as.Message, substring) || strings.Contains(as.Result.Failure, substring) {
+			return true
+		}
+		if as.Error != nil && strings.Contains(as.Error.Error(), substring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var retryFailureEvents = map[EventType]bool{
+	EventTypeCaseFailed:           true,
+	EventTypeCasePanic:            true,
+	EventTypeCaseTimeout:          true,
+	EventTypeStepPanic:            true,
+	EventTypeStepTimeout:          true,
+	EventTypeSetupPanic:           true,
+	EventTypeTeardownPanic:        true,
+	EventTypeFixtureSetupFailed:   true,
+	EventTypeFixtureCleanupPanic:  true,
+	EventTypeResourceSetupFailed:  true,
+	EventTypeResourceLeaseTimeout: true,
+}
+
 func (r *Retry) Copy() Retry {
 	return Retry{
 		Times:    r.Times,
 		Delay:    r.Delay,
+		Backoff:  r.Backoff,
+		RetryOn:  r.RetryOn,
 		TimesSet: r.TimesSet,
 		DelaySet: r.DelaySet,
 	}
@@ -57,6 +180,12 @@ func (r *Retry) Join(other Retry) Retry {
 		result.Delay = other.Delay
 		result.DelaySet = true
 	}
+	if other.Backoff != nil {
+		result.Backoff = other.Backoff
+	}
+	if other.RetryOn != nil {
+		result.RetryOn = other.RetryOn
+	}
 
 	return result
 }
@@ -75,3 +204,35 @@ func (r *Retry) Normalize() {
 		r.Delay = 0
 	}
 }
+
+func (r *Retry) DelayFor(attempt int, previous time.Duration) time.Duration {
+	if attempt <= 1 {
+		return 0
+	}
+	if r.Backoff == nil {
+		return r.Delay
+	}
+
+	delay := r.Backoff(attempt, r.Delay, previous)
+	if delay < 0 {
+		return 0
+	}
+
+	return delay
+}
+
+func (r *Retry) ShouldRetry(a RetryAttempt) bool {
+	if r.RetryOn == nil {
+		return true
+	}
+
+	return r.RetryOn(a)
+}
+
+func capBackoff(delay, max time.Duration) time.Duration {
+	if max > 0 && delay > max {
+		return max
+	}
+
+	return delay
+}
@@ -1,6 +1,7 @@
 package axiom_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -271,3 +272,105 @@ func TestRetryCopy(t *testing.T) {
 
 	assert.Equal(t, r, cp)
 }
+
+func TestRetryJoin_OverridesBackoffAndRetryOn_WhenSet(t *testing.T) {
+	base := axiom.NewRetry(
+		axiom.WithRetryBackoff(axiom.NewConstantBackoff()),
+		axiom.WithRetryOn(axiom.RetryOnMessage("base")),
+	)
+	other := axiom.NewRetry(
+		axiom.WithRetryOn(axiom.RetryOnMessage("other")),
+	)
+
+	result := base.Join(other)
+
+	failure := func(message string) axiom.RetryAttempt {
+		return axiom.RetryAttempt{Events: []axiom.Event{
+			axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage(message)),
+		}}
+	}
+
+	assert.NotNil(t, result.Backoff)
+	assert.False(t, result.ShouldRetry(failure("base")))
+	assert.True(t, result.ShouldRetry(failure("other")))
+}
+
+func TestRetryDelayFor_UsesFixedDelayWithoutBackoff(t *testing.T) {
+	r := axiom.NewRetry(axiom.WithRetryDelay(10 * time.Millisecond))
+
+	assert.Equal(t, time.Duration(0), r.DelayFor(1, 0))
+	assert.Equal(t, 10*time.Millisecond, r.DelayFor(2, 0))
+	assert.Equal(t, 10*time.Millisecond, r.DelayFor(5, 10*time.Millisecond))
+}
+
+func TestRetryDelayFor_Backoffs(t *testing.T) {
+	base := 10 * time.Millisecond
+
+	linear := axiom.NewRetry(axiom.WithRetryDelay(base), axiom.WithRetryBackoff(axiom.NewLinearBackoff(25*time.Millisecond)))
+	assert.Equal(t, 10*time.Millisecond, linear.DelayFor(2, 0))
+	assert.Equal(t, 20*time.Millisecond, linear.DelayFor(3, 0))
+	assert.Equal(t, 25*time.Millisecond, linear.DelayFor(4, 0))
+
+	exponential := axiom.NewRetry(axiom.WithRetryDelay(base), axiom.WithRetryBackoff(axiom.NewExponentialBackoff(50*time.Millisecond)))
+	assert.Equal(t, 10*time.Millisecond, exponential.DelayFor(2, 0))
+	assert.Equal(t, 20*time.Millisecond, exponential.DelayFor(3, 0))
+	assert.Equal(t, 40*time.Millisecond, exponential.DelayFor(4, 0))
+	assert.Equal(t, 50*time.Millisecond, exponential.DelayFor(5, 0))
+	assert.Equal(t, 50*time.Millisecond, exponential.DelayFor(64, 0))
+}
+
+func TestRetryDelayFor_DecorrelatedJitterStaysInBounds(t *testing.T) {
+	r := axiom.NewRetry(
+		axiom.WithRetryDelay(10*time.Millisecond),
+		axiom.WithRetryBackoff(axiom.NewDecorrelatedJitterBackoff(100*time.Millisecond)),
+	)
+
+	previous := time.Duration(0)
+	for attempt := 2; attempt < 50; attempt++ {
+		delay := r.DelayFor(attempt, previous)
+
+		assert.GreaterOrEqual(t, delay, 10*time.Millisecond)
+		assert.LessOrEqual(t, delay, 100*time.Millisecond)
+		previous = delay
+	}
+}
+
+func TestRetryOnMessage_MatchesFailureEventsAndFailedAsserts(t *testing.T) {
+	predicate := axiom.RetryOnMessage("connection reset")
+	failed := func(a axiom.Assert) axiom.Assert {
+		result := axiom.EvaluateAssert(a)
+		a.Result = &result
+		return a
+	}
+
+	assert.True(t, predicate(axiom.RetryAttempt{
+		Events: []axiom.Event{axiom.NewEvent(axiom.EventTypeCasePanic, axiom.WithEventMessage("read: connection reset by peer"))},
+	}))
+	assert.True(t, predicate(axiom.RetryAttempt{
+		Events: []axiom.Event{axiom.NewEvent(axiom.EventTypeCaseFailed, axiom.WithEventMessage("connection reset"))},
+	}))
+	assert.True(t, predicate(axiom.RetryAttempt{
+		Asserts: []axiom.Assert{failed(axiom.NewNoErrorAssert(errors.New("connection reset"), "call"))},
+	}))
+	assert.False(t, predicate(axiom.RetryAttempt{
+		Asserts: []axiom.Assert{failed(axiom.NewEqualAssert(1, 2, "status"))},
+	}))
+}
+
+func TestRetryOnMessage_IgnoresLogsPassedAssertsAndOtherEvents(t *testing.T) {
+	predicate := axiom.RetryOnMessage("connection reset")
+	passed := axiom.NewEqualAssert(1, 1, "connection reset is handled")
+	passed.Result = &axiom.AssertResult{Passed: true}
+
+	assert.False(t, predicate(axiom.RetryAttempt{
+		Logs: []axiom.Log{axiom.NewErrorLog("connection reset")},
+		Events: []axiom.Event{
+			axiom.NewEvent(axiom.EventTypeStepStart, axiom.WithEventMessage("connection reset")),
+			axiom.NewLogEvent(axiom.NewInfoLog("retrying after connection reset")),
+		},
+		Asserts: []axiom.Assert{
+			passed,
+			axiom.NewNoErrorAssert(errors.New("connection reset"), "not evaluated"),
+		},
+	}))
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 type Runner struct {
@@ -12,16 +13,23 @@ type Runner struct {
 
 	managed atomic.Bool
 
-	Meta      Meta
-	Skip      Skip
-	Retry     Retry
-	Hooks     Hooks
-	Context   Context
-	Runtime   Runtime
-	Plugins   []Plugin
-	Parallel  Parallel
-	Fixtures  Fixtures
-	Resources Resources
+	fixtureGroups fixtureGroups
+	concurrency   concurrencyLimiter
+	warmUpErr     error
+
+	Meta       Meta
+	Skip       Skip
+	Retry      Retry
+	Hooks      Hooks
+	Context    Context
+	Runtime    Runtime
+	Plugins    []Plugin
+	Timeout    Timeout
+	Parallel   Parallel
+	Fixtures   Fixtures
+	Resources  Resources
+	Quarantine Quarantine
+	Soft       Soft
 }
 
 type RunnerOption func(*Runner)
@@ -35,6 +43,7 @@ func NewRunner(options ...RunnerOption) *Runner {
 	r.Meta.Normalize()
 	r.Retry.Normalize()
 	r.Context.Normalize()
+	r.Timeout.Normalize()
 	r.Fixtures.Normalize()
 	r.Resources.Normalize()
 
@@ -55,6 +64,20 @@ func WithRunnerSkip(options ...SkipOption) RunnerOption {
 	}
 }
 
+func WithRunnerQuarantine(options ...QuarantineOption) RunnerOption {
+	return func(r *Runner) {
+		q := NewQuarantine(options...)
+		r.Quarantine = r.Quarantine.Join(q)
+	}
+}
+
+func WithRunnerSoft(options ...SoftOption) RunnerOption {
+	return func(r *Runner) {
+		s := NewSoft(options...)
+		r.Soft = r.Soft.Join(s)
+	}
+}
+
 func WithRunnerRetry(options ...RetryOption) RunnerOption {
 	return func(r *Runner) {
 		rr := NewRetry(options...)
@@ -90,6 +113,13 @@ func WithRunnerPlugins(plugins ...Plugin) RunnerOption {
 	}
 }
 
+func WithRunnerTimeout(options ...TimeoutOption) RunnerOption {
+	return func(r *Runner) {
+		t := NewTimeout(options...)
+		r.Timeout = r.Timeout.Join(t)
+	}
+}
+
 func WithRunnerParallel(options ...ParallelOption) RunnerOption {
 	return func(r *Runner) {
 		p := NewParallel(options...)
@@ -106,6 +136,42 @@ func WithRunnerFixture(name string, fx Fixture) RunnerOption {
 	}
 }
 
+func WithRunnerFixtureDeps(name string, deps ...string) RunnerOption {
+	return func(r *Runner) { WithFixtureDeps(name, deps...)(&r.Fixtures) }
+}
+
+func WithRunnerFixtureScope(name string, scope FixtureScope) RunnerOption {
+	return func(r *Runner) { WithFixtureScope(name, scope)(&r.Fixtures) }
+}
+
+func WithRunnerFixtureKey[T any](key FixtureKey[T], fx TypedFixture[T]) RunnerOption {
+	return WithRunnerFixture(key.Name(), key.Fixture(fx))
+}
+
+func WithRunnerResourceKey[T any](key ResourceKey[T], rs TypedResource[T]) RunnerOption {
+	return WithRunnerResource(key.Name(), key.Resource(rs))
+}
+
+func WithRunnerResourcePolicy(name string, options ...ResourcePolicyOption) RunnerOption {
+	return func(r *Runner) { WithResourcePolicy(name, options...)(&r.Resources) }
+}
+
+func WithRunnerResourcePool(name string, instances ...any) RunnerOption {
+	return func(r *Runner) { WithResourcePool(name, instances...)(&r.Resources) }
+}
+
+func WithRunnerResourceWarmUp(names ...string) RunnerOption {
+	return func(r *Runner) { WithResourceWarmUp(names...)(&r.Resources) }
+}
+
+func WithRunnerResourceWarmUpTimeout(timeout time.Duration) RunnerOption {
+	return func(r *Runner) { WithResourceWarmUpTimeout(timeout)(&r.Resources) }
+}
+
+func WithRunnerResourceHealthCheck(name string, check ResourceHealthCheck) RunnerOption {
+	return func(r *Runner) { WithResourceHealthCheck(name, check)(&r.Resources) }
+}
+
 func WithRunnerResource(name string, rs Resource) RunnerOption {
 	return func(r *Runner) {
 		if r.Resources.Registry == nil {
@@ -117,16 +183,19 @@ func WithRunnerResource(name string, rs Resource) RunnerOption {
 
 func (r *Runner) Join(other *Runner) *Runner {
 	return &Runner{
-		Meta:      r.Meta.Join(other.Meta),
-		Skip:      r.Skip.Join(other.Skip),
-		Retry:     r.Retry.Join(other.Retry),
-		Hooks:     r.Hooks.Join(other.Hooks),
-		Context:   r.Context.Join(other.Context),
-		Runtime:   r.Runtime.Join(other.Runtime),
-		Plugins:   append(r.Plugins, other.Plugins...),
-		Fixtures:  r.Fixtures.Join(other.Fixtures),
-		Parallel:  r.Parallel.Join(other.Parallel),
-		Resources: r.Resources.Join(other.Resources),
+		Meta:       r.Meta.Join(other.Meta),
+		Skip:       r.Skip.Join(other.Skip),
+		Retry:      r.Retry.Join(other.Retry),
+		Hooks:      r.Hooks.Join(other.Hooks),
+		Context:    r.Context.Join(other.Context),
+		Runtime:    r.Runtime.Join(other.Runtime),
+		Plugins:    append(r.Plugins, other.Plugins...),
+		Timeout:    r.Timeout.Join(other.Timeout),
+		Fixtures:   r.Fixtures.Join(other.Fixtures),
+		Parallel:   r.Parallel.Join(other.Parallel),
+		Resources:  r.Resources.Join(other.Resources),
+		Quarantine: r.Quarantine.Join(other.Quarantine),
+		Soft:       r.Soft.Join(other.Soft),
 	}
 }
 
@@ -135,6 +204,9 @@ func (r *Runner) RunCase(t *testing.T, c Case, action TestAction) {
 	if !r.managed.Load() {
 		t.Cleanup(r.ApplyFinish)
 	}
+	if r.warmUpErr != nil {
+		t.Fatal(r.warmUpErr)
+	}
 
 	r.runCase(t, c, action)
 }
@@ -158,45 +230,63 @@ func (r *Runner) BuildConfig(t *testing.T, c *Case) *Config {
 	hooks := r.Hooks.Join(c.Hooks)
 	context := r.Context.Join(c.Context)
 	runtime := r.Runtime.Join(c.Runtime)
+	timeout := r.Timeout.Join(c.Timeout)
 	parallel := r.Parallel.Join(c.Parallel)
 	fixtures := r.Fixtures.Join(c.Fixtures)
+	quarantine := r.Quarantine.Join(c.Quarantine)
+	soft := r.Soft.Join(c.Soft)
 
 	cfg := &Config{
-		Case:     c,
-		Skip:     skip,
-		Meta:     meta,
-		Retry:    retry,
-		Hooks:    hooks,
-		RootT:    t,
-		Runner:   r,
-		Context:  context,
-		Runtime:  runtime,
-		Parallel: parallel,
-		Fixtures: fixtures,
+		Case:       c,
+		Skip:       skip,
+		Meta:       meta,
+		Retry:      retry,
+		Hooks:      hooks,
+		RootT:      t,
+		Runner:     r,
+		Context:    context,
+		Runtime:    runtime,
+		Timeout:    timeout,
+		Parallel:   parallel,
+		Fixtures:   fixtures,
+		Quarantine: quarantine,
+		Soft:       soft,
 	}
 
 	cfg.Meta.Normalize()
 	cfg.Retry.Normalize()
 	cfg.Context.Normalize()
+	cfg.Timeout.Normalize()
 	cfg.Fixtures.Normalize()
+	cfg.Soft.Normalize()
+
+	// Reported when the case starts, so the failure belongs to its attempt.
+	cfg.fixturesErr = cfg.Fixtures.Validate()
 
 	return cfg
 }
 
+// ApplyStart runs BeforeAll hooks once and then warms up resources; a
+// warm-up failure is kept in WarmUpErr and fails every case of the runner.
 func (r *Runner) ApplyStart() {
 	r.beforeOnce.Do(func() {
-		r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllStart))
-		defer func() {
-			if v := recover(); v != nil {
-				r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllPanic, WithEventMessage(v)))
-				panic(v)
-			}
+		r.applyBeforeAll()
+		r.warmUpErr = r.WarmUpResources()
+	})
+}
 
-			r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllFinish))
-		}()
+func (r *Runner) applyBeforeAll() {
+	r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllStart))
+	defer func() {
+		if v := recover(); v != nil {
+			r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllPanic, WithEventMessage(v)))
+			panic(v)
+		}
 
-		r.Hooks.ApplyBeforeAll(r)
-	})
+		r.Runtime.Event(NewEvent(EventTypeRunnerBeforeAllFinish))
+	}()
+
+	r.Hooks.ApplyBeforeAll(r)
 }
 
 func (r *Runner) ApplyFinish() {
@@ -212,6 +302,7 @@ func (r *Runner) ApplyFinish() {
 		}()
 
 		defer r.Resources.Teardown(r)
+		defer r.teardownRunnerFixtures()
 		r.Hooks.ApplyAfterAll(r)
 	})
 }
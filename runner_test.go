@@ -204,6 +204,24 @@ func TestRunnerBuildConfig_CaseNotSet_KeepsRunnerParallel(t *testing.T) {
 	assert.True(t, cfg.Parallel.EnabledSet)
 }
 
+func TestRunnerBuildConfig_CaseTimeoutOverridesRunnerPerField(t *testing.T) {
+	r := axiom.NewRunner(
+		axiom.WithRunnerTimeout(
+			axiom.WithTimeoutCase(time.Minute),
+			axiom.WithTimeoutStep(10*time.Second),
+		),
+	)
+
+	c := axiom.NewCase(
+		axiom.WithCaseTimeout(axiom.WithTimeoutStep(time.Second)),
+	)
+
+	cfg := r.BuildConfig(&testing.T{}, &c)
+
+	assert.Equal(t, time.Minute, cfg.Timeout.Case)
+	assert.Equal(t, time.Second, cfg.Timeout.Step)
+}
+
 func TestRunnerBuildConfig_LocalIsFresh(t *testing.T) {
 	r := axiom.NewRunner()
 	c := axiom.NewCase()
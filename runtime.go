@@ -10,6 +10,11 @@ type WrapStepAction func(name string, next StepAction) StepAction
 type WrapSetupAction func(name string, next SetupAction) SetupAction
 type WrapTeardownAction func(name string, next TeardownAction) TeardownAction
 
+// WrapSpanAction wraps every step, setup and teardown like the wraps above and
+// also receives the Config running it. Steps started inside a ParallelSteps
+// branch run on the Config of the branch, whose Span identifies them.
+type WrapSpanAction func(cfg *Config, name string, next func()) func()
+
 type SinkLogAction func(l Log)
 type SinkEventAction func(e Event)
 type SinkAssertAction func(a Assert)
@@ -20,11 +25,14 @@ type Runtime struct {
 	StepWraps     []WrapStepAction
 	SetupWraps    []WrapSetupAction
 	TeardownWraps []WrapTeardownAction
+	SpanWraps     []WrapSpanAction
 
 	LogSinks      []SinkLogAction
 	EventSinks    []SinkEventAction
 	AssertSinks   []SinkAssertAction
 	ArtefactSinks []SinkArtefactAction
+
+	AssertEvaluator AssertEvaluator
 }
 
 type RuntimeOption func(*Runtime)
@@ -54,6 +62,10 @@ func WithRuntimeTeardownWrap(w WrapTeardownAction) RuntimeOption {
 	return func(r *Runtime) { r.EmitTeardownWrap(w) }
 }
 
+func WithRuntimeSpanWrap(w WrapSpanAction) RuntimeOption {
+	return func(r *Runtime) { r.EmitSpanWrap(w) }
+}
+
 func WithRuntimeLogSink(s SinkLogAction) RuntimeOption {
 	return func(r *Runtime) { r.EmitLogSink(s) }
 }
@@ -70,6 +82,10 @@ func WithRuntimeArtefactSink(s SinkArtefactAction) RuntimeOption {
 	return func(r *Runtime) { r.EmitArtefactSink(s) }
 }
 
+func WithRuntimeAssertEvaluator(e AssertEvaluator) RuntimeOption {
+	return func(r *Runtime) { r.EmitAssertEvaluator(e) }
+}
+
 func (r *Runtime) EmitTestWrap(w WrapTestAction) {
 	if w == nil {
 		return
@@ -98,6 +114,13 @@ func (r *Runtime) EmitTeardownWrap(w WrapTeardownAction) {
 	r.TeardownWraps = append(r.TeardownWraps, w)
 }
 
+func (r *Runtime) EmitSpanWrap(w WrapSpanAction) {
+	if w == nil {
+		return
+	}
+	r.SpanWraps = append(r.SpanWraps, w)
+}
+
 func (r *Runtime) EmitLogSink(s SinkLogAction) {
 	if s == nil {
 		return
@@ -126,6 +149,14 @@ func (r *Runtime) EmitArtefactSink(s SinkArtefactAction) {
 	r.ArtefactSinks = append(r.ArtefactSinks, s)
 }
 
+// EmitAssertEvaluator replaces the evaluator; only one evaluator is active.
+func (r *Runtime) EmitAssertEvaluator(e AssertEvaluator) {
+	if e == nil {
+		return
+	}
+	r.AssertEvaluator = e
+}
+
 func (r *Runtime) Step(name string, fn func()) {
 	wrapped := fn
 	for i := len(r.StepWraps) - 1; i >= 0; i-- {
@@ -160,6 +191,15 @@ func (r *Runtime) Teardown(name string, fn func()) {
 	wrapped()
 }
 
+// Span runs fn, a step, setup or teardown of c, wrapped by the span wraps.
+func (r *Runtime) Span(c *Config, name string, fn func()) {
+	wrapped := fn
+	for i := len(r.SpanWraps) - 1; i >= 0; i-- {
+		wrapped = r.SpanWraps[i](c, name, wrapped)
+	}
+	wrapped()
+}
+
 func (r *Runtime) Log(l Log) {
 	for _, sink := range r.LogSinks {
 		sink(l)
@@ -178,6 +218,14 @@ func (r *Runtime) Assert(a Assert) {
 	}
 }
 
+func (r *Runtime) EvaluateAssert(a Assert) AssertResult {
+	if r.AssertEvaluator != nil {
+		return r.AssertEvaluator(a)
+	}
+
+	return EvaluateAssert(a)
+}
+
 func (r *Runtime) Artefact(a Artefact) {
 	for _, sink := range r.ArtefactSinks {
 		sink(a)
@@ -185,7 +233,7 @@ func (r *Runtime) Artefact(a Artefact) {
 }
 
 func (r *Runtime) Copy() Runtime {
-	var result Runtime
+	result := Runtime{AssertEvaluator: r.AssertEvaluator}
 
 	if r.TestWraps != nil {
 		result.TestWraps = append([]WrapTestAction{}, r.TestWraps...)
@@ -199,6 +247,9 @@ func (r *Runtime) Copy() Runtime {
 	if r.TeardownWraps != nil {
 		result.TeardownWraps = append([]WrapTeardownAction{}, r.TeardownWraps...)
 	}
+	if r.SpanWraps != nil {
+		result.SpanWraps = append([]WrapSpanAction{}, r.SpanWraps...)
+	}
 
 	if r.LogSinks != nil {
 		result.LogSinks = append([]SinkLogAction{}, r.LogSinks...)
@@ -218,16 +269,22 @@ func (r *Runtime) Copy() Runtime {
 
 func (r *Runtime) Join(other Runtime) Runtime {
 	result := r.Copy()
+	if other.AssertEvaluator != nil {
+		result.AssertEvaluator = other.AssertEvaluator
+	}
 
 	return Runtime{
 		TestWraps:     append(result.TestWraps, other.TestWraps...),
 		StepWraps:     append(result.StepWraps, other.StepWraps...),
 		SetupWraps:    append(result.SetupWraps, other.SetupWraps...),
 		TeardownWraps: append(result.TeardownWraps, other.TeardownWraps...),
+		SpanWraps:     append(result.SpanWraps, other.SpanWraps...),
 
 		LogSinks:      append(result.LogSinks, other.LogSinks...),
 		EventSinks:    append(result.EventSinks, other.EventSinks...),
 		AssertSinks:   append(result.AssertSinks, other.AssertSinks...),
 		ArtefactSinks: append(result.ArtefactSinks, other.ArtefactSinks...),
+
+		AssertEvaluator: result.AssertEvaluator,
 	}
 }
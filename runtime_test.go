@@ -5,6 +5,7 @@ import (
 
 	"github.com/Nikita-Filonov/axiom"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewRuntime_Defaults(t *testing.T) {
@@ -398,3 +399,48 @@ func TestRuntimeCopy_SlicesAreIndependent(t *testing.T) {
 	assert.Len(t, rt.EventSinks, 1)
 	assert.Len(t, cp.TestWraps, 2)
 }
+
+func TestRuntime_Join_AssertEvaluatorOverride(t *testing.T) {
+	base := axiom.NewRuntime(axiom.WithRuntimeAssertEvaluator(func(axiom.Assert) axiom.AssertResult {
+		return axiom.AssertResult{Failure: "base"}
+	}))
+
+	inherited := base.Join(axiom.NewRuntime())
+	assert.Equal(t, "base", inherited.EvaluateAssert(axiom.Assert{}).Failure)
+
+	overridden := base.Join(axiom.NewRuntime(axiom.WithRuntimeAssertEvaluator(func(axiom.Assert) axiom.AssertResult {
+		return axiom.AssertResult{Passed: true}
+	})))
+	assert.True(t, overridden.EvaluateAssert(axiom.Assert{}).Passed)
+
+	empty := axiom.NewRuntime()
+	assert.True(t, empty.EvaluateAssert(axiom.NewTrueAssert(true, "")).Passed)
+}
+
+func TestConfig_SpanWrapsReceiveRunningConfig(t *testing.T) {
+	var spans []string
+	cfg := &axiom.Config{
+		SubT: t,
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeSpanWrap(func(c *axiom.Config, name string, next func()) func() {
+				return func() {
+					id, _ := c.Span()
+					spans = append(spans, name+"="+id)
+					next()
+				}
+			}),
+			axiom.WithRuntimeEventSink(func(e axiom.Event) {
+				if e.Type == axiom.EventTypeStepStart || e.Type == axiom.EventTypeSetupStart {
+					spans = append(spans, e.Name+"="+e.ID)
+				}
+			}),
+		),
+	}
+
+	cfg.Setup("prepare", func() {})
+	cfg.Step("work", func() {})
+
+	require.Len(t, spans, 4)
+	assert.Equal(t, spans[0], spans[1])
+	assert.Equal(t, spans[2], spans[3])
+}
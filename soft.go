@@ -0,0 +1,233 @@
+package axiom
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type SoftScope string
+
+const (
+	SoftScopeCase SoftScope = "case"
+	SoftScopeStep SoftScope = "step"
+)
+
+type Soft struct {
+	Scope      SoftScope
+	Enabled    bool
+	EnabledSet bool
+}
+
+type SoftOption func(*Soft)
+
+func NewSoft(options ...SoftOption) Soft {
+	s := Soft{}
+	for _, option := range options {
+		option(&s)
+	}
+
+	return s
+}
+
+func WithSoftEnabled(enabled bool) SoftOption {
+	return func(s *Soft) {
+		s.Enabled = enabled
+		s.EnabledSet = true
+	}
+}
+
+func WithSoftDisabled() SoftOption {
+	return WithSoftEnabled(false)
+}
+
+// WithSoftScope selects where collected failures are reported: once at the
+// end of the case, or at the end of every step.
+func WithSoftScope(scope SoftScope) SoftOption {
+	return func(s *Soft) {
+		s.Scope = scope
+		s.Enabled = true
+		s.EnabledSet = true
+	}
+}
+
+func (s *Soft) Copy() Soft {
+	return Soft{
+		Scope:      s.Scope,
+		Enabled:    s.Enabled,
+		EnabledSet: s.EnabledSet,
+	}
+}
+
+func (s *Soft) Join(other Soft) Soft {
+	result := s.Copy()
+
+	if other.EnabledSet {
+		result.Enabled = other.Enabled
+		result.EnabledSet = true
+	}
+	if other.Scope != "" {
+		result.Scope = other.Scope
+	}
+
+	return result
+}
+
+func (s *Soft) Normalize() {
+	if s.Scope == "" {
+		s.Scope = SoftScopeCase
+	}
+}
+
+type SoftFailure struct {
+	Step     string `json:"step,omitempty"`
+	Type     string `json:"type"`
+	Message  string `json:"message,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Failure  string `json:"failure"`
+	Diff     string `json:"diff,omitempty"`
+	Source   string `json:"source,omitempty"`
+}
+
+// softScope collects failed assertions. Branches of ParallelSteps started
+// inside a scope add to it concurrently.
+type softScope struct {
+	title string
+
+	mu       sync.Mutex
+	failures []SoftFailure
+}
+
+func (s *softScope) add(f SoftFailure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, f)
+}
+
+func (s *softScope) collected() []SoftFailure {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.failures
+}
+
+func newSoftFailure(step string, a Assert, failure string) SoftFailure {
+	f := SoftFailure{
+		Step:    step,
+		Type:    a.Type.String(),
+		Message: a.Message,
+		Failure: strings.TrimSpace(failure),
+	}
+	if a.Expected != nil {
+		f.Expected = fmt.Sprintf("%#v", a.Expected)
+	}
+	if a.Actual != nil {
+		f.Actual = fmt.Sprintf("%#v", a.Actual)
+	}
+	if a.Error != nil {
+		f.Error = a.Error.Error()
+	}
+	if a.Result != nil {
+		f.Diff = a.Result.Diff
+		f.Source = a.Result.Source()
+	}
+
+	return f
+}
+
+// AssertFailed reports a failed assertion. Inside a soft scope the failure is
+// collected and reported together with the others when the scope ends;
+// otherwise it fails the attempt immediately.
+func (c *Config) AssertFailed(a Assert, failure string) {
+	spans := c.spans()
+	spans.mu.Lock()
+	if len(spans.softScopes) > 0 {
+		scope := spans.softScopes[len(spans.softScopes)-1]
+		step := spans.currentStepLocked()
+		spans.mu.Unlock()
+
+		scope.add(newSoftFailure(step, a, failure))
+		return
+	}
+	spans.mu.Unlock()
+
+	c.Errorf("%s", failure)
+}
+
+// SoftStep runs a step whose failed assertions are collected and reported
+// once when the step ends, regardless of the configured Soft policy.
+func (c *Config) SoftStep(name string, fn func()) {
+	c.Step(name, func() {
+		scope := c.pushSoftScope(fmt.Sprintf("step %q", name))
+		defer c.flushSoftScope(scope)
+
+		fn()
+	})
+}
+
+func (c *Config) softScopeFor(scope SoftScope, name string) *softScope {
+	if !c.Soft.Enabled || c.Soft.Scope != scope {
+		return nil
+	}
+	if scope == SoftScopeCase && c.Case != nil {
+		name = c.Case.Name
+	}
+
+	return c.pushSoftScope(fmt.Sprintf("%s %q", scope, name))
+}
+
+func (c *Config) pushSoftScope(title string) *softScope {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	scope := &softScope{title: title}
+	spans.softScopes = append(spans.softScopes, scope)
+
+	return scope
+}
+
+func (c *Config) flushSoftScope(scope *softScope) {
+	spans := c.spans()
+	spans.mu.Lock()
+	for i := len(spans.softScopes) - 1; i >= 0; i-- {
+		if spans.softScopes[i] == scope {
+			spans.softScopes = spans.softScopes[:i]
+			break
+		}
+	}
+	spans.mu.Unlock()
+
+	failures := scope.collected()
+	if len(failures) == 0 {
+		return
+	}
+
+	if artefact, err := NewJSONArtefact("soft assertions: "+scope.title, failures); err == nil {
+		c.Artefact(artefact)
+	}
+	c.Errorf("%s", softReport(scope.title, failures))
+}
+
+func softReport(title string, failures []SoftFailure) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d soft assertion(s) failed in %s:", len(failures), title)
+
+	for i, f := range failures {
+		fmt.Fprintf(&b, "\n%d) %s", i+1, f.Type)
+		if f.Message != "" {
+			fmt.Fprintf(&b, " %q", f.Message)
+		}
+		if f.Step != "" {
+			fmt.Fprintf(&b, " in step %q", f.Step)
+		}
+		for _, line := range strings.Split(f.Failure, "\n") {
+			b.WriteString("\n   " + line)
+		}
+	}
+
+	return b.String()
+}
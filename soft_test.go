@@ -0,0 +1,141 @@
+package axiom_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSoft_Default(t *testing.T) {
+	s := axiom.NewSoft()
+
+	assert.False(t, s.Enabled)
+	assert.False(t, s.EnabledSet)
+	assert.Equal(t, axiom.SoftScope(""), s.Scope)
+}
+
+func TestWithSoftScope_Enables(t *testing.T) {
+	s := axiom.NewSoft(axiom.WithSoftScope(axiom.SoftScopeStep))
+
+	assert.True(t, s.Enabled)
+	assert.True(t, s.EnabledSet)
+	assert.Equal(t, axiom.SoftScopeStep, s.Scope)
+}
+
+func TestSoft_Join(t *testing.T) {
+	base := axiom.NewSoft(axiom.WithSoftScope(axiom.SoftScopeStep))
+
+	inherited := base.Join(axiom.NewSoft())
+	assert.True(t, inherited.Enabled)
+	assert.Equal(t, axiom.SoftScopeStep, inherited.Scope)
+
+	disabled := base.Join(axiom.NewSoft(axiom.WithSoftDisabled()))
+	assert.False(t, disabled.Enabled)
+	assert.True(t, disabled.EnabledSet)
+	assert.Equal(t, axiom.SoftScopeStep, disabled.Scope)
+}
+
+func TestSoft_Copy(t *testing.T) {
+	s := axiom.NewSoft(axiom.WithSoftEnabled(true))
+
+	assert.Equal(t, s, s.Copy())
+}
+
+func TestSoft_Normalize(t *testing.T) {
+	s := axiom.NewSoft(axiom.WithSoftEnabled(true))
+	s.Normalize()
+
+	assert.Equal(t, axiom.SoftScopeCase, s.Scope)
+}
+
+func TestConfig_AssertFailed_FailsImmediatelyWithoutSoft(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT}
+
+	cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "values"), "not equal")
+
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{"not equal"}, cfg.Failures())
+}
+
+func TestConfig_Test_SoftCaseAggregatesFailures(t *testing.T) {
+	fakeT := &testing.T{}
+	var artefacts []axiom.Artefact
+
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Case: &axiom.Case{Name: "soft case"},
+		Soft: axiom.NewSoft(axiom.WithSoftScope(axiom.SoftScopeCase)),
+		Runtime: axiom.NewRuntime(axiom.WithRuntimeArtefactSink(func(a axiom.Artefact) {
+			artefacts = append(artefacts, a)
+		})),
+	}
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("first", func() {
+			c.AssertFailed(axiom.NewEqualAssert(1, 2, "ids"), "not equal")
+		})
+		c.AssertFailed(axiom.NewTrueAssert(false, "flag"), "should be true")
+
+		assert.False(t, fakeT.Failed(), "soft failures must be deferred")
+	})
+
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, []string{
+		"2 soft assertion(s) failed in case \"soft case\":\n" +
+			"1) equal \"ids\" in step \"first\"\n" +
+			"   not equal\n" +
+			"2) true \"flag\"\n" +
+			"   should be true",
+	}, cfg.Failures())
+
+	if assert.Len(t, artefacts, 1) {
+		assert.Equal(t, `soft assertions: case "soft case"`, artefacts[0].Name)
+
+		var failures []axiom.SoftFailure
+		assert.NoError(t, json.Unmarshal(artefacts[0].Data, &failures))
+		assert.Len(t, failures, 2)
+		assert.Equal(t, "first", failures[0].Step)
+		assert.Equal(t, "1", failures[0].Expected)
+		assert.Equal(t, "2", failures[0].Actual)
+	}
+}
+
+func TestConfig_Step_SoftStepScopeReportsPerStep(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		SubT: fakeT,
+		Soft: axiom.NewSoft(axiom.WithSoftScope(axiom.SoftScopeStep)),
+	}
+
+	cfg.Step("first", func() {
+		cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "a"), "a failed")
+		cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "b"), "b failed")
+	})
+	cfg.Step("second", func() {
+		cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "c"), "c failed")
+	})
+
+	if assert.Len(t, cfg.Failures(), 2) {
+		assert.Contains(t, cfg.Failures()[0], `2 soft assertion(s) failed in step "first"`)
+		assert.Contains(t, cfg.Failures()[1], `1 soft assertion(s) failed in step "second"`)
+	}
+}
+
+func TestConfig_SoftStep_CollectsWithoutSoftPolicy(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{SubT: fakeT}
+
+	cfg.SoftStep("checks", func() {
+		cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "a"), "a failed")
+		cfg.AssertFailed(axiom.NewEqualAssert(1, 2, "b"), "b failed")
+		assert.False(t, fakeT.Failed())
+	})
+
+	assert.True(t, fakeT.Failed())
+	if assert.Len(t, cfg.Failures(), 1) {
+		assert.Contains(t, cfg.Failures()[0], `2 soft assertion(s) failed in step "checks"`)
+	}
+}
@@ -0,0 +1,150 @@
+package axiom
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+var spanSeq atomic.Uint64
+
+type frameKind int
+
+const (
+	frameAttempt frameKind = iota
+	frameCase
+	frameStep
+	frameSetup
+	frameTeardown
+)
+
+type frame struct {
+	id       string
+	parentID string
+	kind     frameKind
+	name     string
+	steps    []string
+	start    time.Time
+}
+
+func (f frame) elapsed() time.Duration { return time.Since(f.start) }
+
+func newSpanID() string {
+	return strconv.FormatUint(spanSeq.Add(1), 16)
+}
+
+func (c *Config) pushFrame(kind frameKind, name string) frame {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	f := frame{id: newSpanID(), kind: kind, name: name, start: time.Now()}
+	if len(spans.frames) > 0 {
+		top := spans.frames[len(spans.frames)-1]
+		f.parentID = top.id
+		f.steps = top.steps
+	}
+	if kind == frameStep {
+		f.steps = append(append([]string{}, f.steps...), name)
+	}
+
+	spans.frames = append(spans.frames, f)
+	return f
+}
+
+// popFrame removes f and everything above it, which is only left behind when
+// a nested frame exited through runtime.Goexit.
+func (c *Config) popFrame(f frame) {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	if f.kind == frameStep {
+		step := spans.currentStepLocked()
+		for _, timeout := range spans.timeouts {
+			timeout.captureLocked(step)
+		}
+	}
+
+	for i := len(spans.frames) - 1; i >= 0; i-- {
+		if spans.frames[i].id == f.id {
+			spans.frames = spans.frames[:i]
+			return
+		}
+	}
+}
+
+func (c *Config) currentStep() string {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	return spans.currentStepLocked()
+}
+
+func (c *Config) currentStepLocked() string {
+	for i := len(c.frames) - 1; i >= 0; i-- {
+		if c.frames[i].kind == frameStep {
+			return c.frames[i].name
+		}
+	}
+
+	return ""
+}
+
+func (c *Config) currentFrame() (frame, bool) {
+	spans := c.spans()
+	spans.mu.Lock()
+	defer spans.mu.Unlock()
+
+	if len(spans.frames) == 0 {
+		return frame{}, false
+	}
+
+	return spans.frames[len(spans.frames)-1], true
+}
+
+// Span returns the span of the innermost case, step, setup or teardown running
+// on c, as stamped on events in ID and ParentID. Steps of a ParallelSteps
+// branch run on the Config of the branch.
+func (c *Config) Span() (id, parentID string) {
+	top, ok := c.currentFrame()
+	if !ok {
+		return "", ""
+	}
+
+	return top.id, top.parentID
+}
+
+func (c *Config) emit(e Event) {
+	if e.ID == "" && e.ParentID == "" {
+		if top, ok := c.currentFrame(); ok {
+			e.ParentID = top.id
+			e.Steps = top.steps
+		}
+	}
+
+	c.Runtime.Event(c.stampEvent(e))
+}
+
+func (c *Config) emitSpan(f frame, eventType EventType, options ...EventOption) {
+	e := NewEvent(eventType, append([]EventOption{WithEventName(f.name)}, options...)...)
+	e.ID = f.id
+	e.ParentID = f.parentID
+	e.Steps = f.steps
+
+	c.Runtime.Event(c.stampEvent(e))
+}
+
+func (c *Config) stampEvent(e Event) Event {
+	if c.Case != nil {
+		e.CaseID = c.Case.ID
+		e.CaseName = c.Case.Name
+	}
+	e.Attempt = c.Attempt
+	if len(e.Steps) > 0 {
+		e.Steps = append([]string{}, e.Steps...)
+	}
+
+	return e
+}
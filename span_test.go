@@ -0,0 +1,154 @@
+package axiom_test
+
+import (
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func recordEvents(cfg *axiom.Config) *[]axiom.Event {
+	events := &[]axiom.Event{}
+	cfg.Runtime.EmitEventSink(func(e axiom.Event) { *events = append(*events, e) })
+	return events
+}
+
+func findEvent(t *testing.T, events []axiom.Event, eventType axiom.EventType, name string) axiom.Event {
+	t.Helper()
+	for _, e := range events {
+		if e.Type == eventType && e.Name == name {
+			return e
+		}
+	}
+	require.Failf(t, "event not found", "%s %q", eventType, name)
+	return axiom.Event{}
+}
+
+func TestConfig_Events_CarryCaseIdentityAndStepPath(t *testing.T) {
+	cfg := &axiom.Config{
+		SubT:    t,
+		Case:    &axiom.Case{ID: "AX-1", Name: "create user"},
+		Attempt: 2,
+	}
+	events := recordEvents(cfg)
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("outer", func() {
+			c.Step("inner", func() {
+				c.Log(axiom.NewInfoLog("hello"))
+			})
+			c.Setup("db", func() {})
+		})
+	})
+
+	caseStart := findEvent(t, *events, axiom.EventTypeCaseStart, "")
+	outer := findEvent(t, *events, axiom.EventTypeStepStart, "outer")
+	inner := findEvent(t, *events, axiom.EventTypeStepStart, "inner")
+	log := findEvent(t, *events, axiom.EventTypeLog, "info")
+	setup := findEvent(t, *events, axiom.EventTypeSetupStart, "db")
+
+	for _, e := range *events {
+		assert.Equal(t, "AX-1", e.CaseID, e.Type)
+		assert.Equal(t, "create user", e.CaseName, e.Type)
+		assert.Equal(t, 2, e.Attempt, e.Type)
+	}
+
+	assert.NotEmpty(t, caseStart.ID)
+	assert.Empty(t, caseStart.ParentID)
+	assert.Empty(t, caseStart.Steps)
+
+	assert.Equal(t, caseStart.ID, outer.ParentID)
+	assert.Equal(t, []string{"outer"}, outer.Steps)
+
+	assert.Equal(t, outer.ID, inner.ParentID)
+	assert.Equal(t, []string{"outer", "inner"}, inner.Steps)
+
+	assert.Empty(t, log.ID)
+	assert.Equal(t, inner.ID, log.ParentID)
+	assert.Equal(t, []string{"outer", "inner"}, log.Steps)
+
+	assert.Equal(t, outer.ID, setup.ParentID)
+	assert.Equal(t, []string{"outer"}, setup.Steps)
+}
+
+func TestConfig_Events_FinishEventsCarryDurationAndSpan(t *testing.T) {
+	cfg := &axiom.Config{SubT: t, Case: &axiom.Case{Name: "durations"}}
+	events := recordEvents(cfg)
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("work", func() {})
+		c.Teardown("cleanup", func() {})
+	})
+
+	for _, pair := range [][2]axiom.EventType{
+		{axiom.EventTypeCaseStart, axiom.EventTypeCaseFinish},
+		{axiom.EventTypeStepStart, axiom.EventTypeStepFinish},
+		{axiom.EventTypeTeardownStart, axiom.EventTypeTeardownFinish},
+	} {
+		var start, finish axiom.Event
+		for _, e := range *events {
+			switch e.Type {
+			case pair[0]:
+				start = e
+			case pair[1]:
+				finish = e
+			}
+		}
+
+		assert.Equal(t, start.ID, finish.ID, pair[1])
+		assert.Equal(t, start.ParentID, finish.ParentID, pair[1])
+		assert.Zero(t, start.Duration, pair[0])
+		assert.Positive(t, finish.Duration, pair[1])
+	}
+}
+
+func TestConfig_Events_SequenceIsStrictlyIncreasing(t *testing.T) {
+	cfg := &axiom.Config{SubT: t, Case: &axiom.Case{Name: "seq"}}
+	events := recordEvents(cfg)
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("a", func() {})
+		c.Step("b", func() {})
+	})
+
+	require.NotEmpty(t, *events)
+	for i := 1; i < len(*events); i++ {
+		assert.Greater(t, (*events)[i].Seq, (*events)[i-1].Seq)
+	}
+}
+
+func TestConfig_Events_StepPanicKeepsStepSpan(t *testing.T) {
+	cfg := &axiom.Config{SubT: &testing.T{}, Case: &axiom.Case{Name: "panic"}}
+	events := recordEvents(cfg)
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("boom", func() { panic("x") })
+		c.Step("after", func() {})
+	})
+
+	start := findEvent(t, *events, axiom.EventTypeStepStart, "boom")
+	panicEvent := findEvent(t, *events, axiom.EventTypeStepPanic, "boom")
+	after := findEvent(t, *events, axiom.EventTypeStepStart, "after")
+
+	assert.Equal(t, start.ID, panicEvent.ID)
+	assert.Equal(t, []string{"boom"}, panicEvent.Steps)
+	assert.Equal(t, []string{"after"}, after.Steps)
+	assert.Equal(t, start.ParentID, after.ParentID)
+}
+
+func TestRunner_Events_CarryAttemptNumber(t *testing.T) {
+	var attempts []int
+
+	runner := axiom.NewRunner(
+		axiom.WithRunnerRuntime(axiom.WithRuntimeEventSink(func(e axiom.Event) {
+			if e.Type == axiom.EventTypeCaseStart {
+				attempts = append(attempts, e.Attempt)
+			}
+		})),
+	)
+
+	runner.RunCase(t, axiom.NewCase(axiom.WithCaseName("attempts")), func(cfg *axiom.Config) {})
+
+	assert.Equal(t, []int{1}, attempts)
+}
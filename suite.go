@@ -141,6 +141,7 @@ func (s *SuiteRunner[T]) Run() {
 
 			runner.ApplyStart()
 			s.rootT.Cleanup(runner.ApplyFinish)
+			runner.bindFixtureGroup(st, s.rootT)
 
 			if parallel {
 				st.Parallel()
@@ -0,0 +1,161 @@
+package axiom
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// MetaLabelParamPrefix prefixes the labels that record the params of a table
+// row, one label per top-level field.
+const MetaLabelParamPrefix = "param."
+
+// TableRow is a named row of a CaseTable. Its options are applied after the
+// table case, so a row may override skip, retry, meta or any other setting.
+type TableRow[T any] struct {
+	Name    string
+	Params  T
+	Options []CaseOption
+}
+
+// CaseTable expands Case into one case per row, each with the params of its
+// row.
+type CaseTable[T any] struct {
+	Case Case
+	Rows []TableRow[T]
+}
+
+type TableAction[T any] func(cfg *Config, params T)
+
+func NewTableRow[T any](name string, params T, options ...CaseOption) TableRow[T] {
+	return TableRow[T]{Name: name, Params: params, Options: options}
+}
+
+func NewCaseTable[T any](c Case, rows ...TableRow[T]) CaseTable[T] {
+	return CaseTable[T]{Case: c, Rows: rows}
+}
+
+// Cases returns the case of every row: the table case named after the row,
+// with the row name appended to its ID and the row params recorded in
+// Meta.Labels.
+func (t CaseTable[T]) Cases() []Case {
+	seen := make(map[string]bool, len(t.Rows))
+	cases := make([]Case, 0, len(t.Rows))
+	for _, row := range t.Rows {
+		if row.Name == "" {
+			panic("table: row name must not be empty")
+		}
+		if seen[row.Name] {
+			panic("table: duplicate row name: " + row.Name)
+		}
+		seen[row.Name] = true
+
+		c := t.Case.Copy()
+		c.Name = row.Name
+		if c.ID != "" {
+			c.ID += "/" + row.Name
+		}
+		c.Params = row.Params
+		c.Meta = c.Meta.Join(NewMeta(WithMetaLabels(paramLabels(row.Params))))
+		for _, option := range row.Options {
+			option(&c)
+		}
+
+		cases = append(cases, c)
+	}
+
+	return cases
+}
+
+// RunTable runs the case of every row as a subtest of a test named after the
+// table case. The action receives the typed params of the row, which are
+// also attached to the attempt as a JSON artefact.
+func RunTable[T any](t *testing.T, r *Runner, table CaseTable[T], action TableAction[T]) {
+	if t == nil {
+		panic("table: nil *testing.T")
+	}
+	if r == nil {
+		panic("table: nil *Runner")
+	}
+
+	cases, testAction := table.Cases(), tableAction(action)
+	runTableGroup(t, table.Case.Name, func(t *testing.T) {
+		for _, c := range cases {
+			r.RunCase(t, c, testAction)
+		}
+	})
+}
+
+// RunSuiteTable is RunTable for a suite test, running the rows with the
+// runner of the suite.
+func RunSuiteTable[T any](s *Suite, table CaseTable[T], action TableAction[T]) {
+	if s == nil {
+		panic("suite: nil Suite")
+	}
+	if s.Runner == nil {
+		panic("suite: runner is not configured")
+	}
+	if s.SubT == nil {
+		panic("suite: nil *testing.T")
+	}
+
+	cases, testAction := table.Cases(), tableAction(action)
+	runTableGroup(s.SubT, table.Case.Name, func(t *testing.T) {
+		for _, c := range cases {
+			s.Runner.runCase(t, c, testAction)
+		}
+	})
+}
+
+func tableAction[T any](action TableAction[T]) TestAction {
+	if action == nil {
+		panic("table: nil action")
+	}
+
+	return func(cfg *Config) {
+		params := GetParams[T](cfg)
+		if artefact, err := NewJSONArtefact("params: "+cfg.Case.Name, params); err == nil {
+			cfg.Artefact(artefact)
+		}
+
+		action(cfg, params)
+	}
+}
+
+func runTableGroup(t *testing.T, name string, run func(t *testing.T)) {
+	if name == "" {
+		run(t)
+		return
+	}
+
+	t.Run(name, run)
+}
+
+// paramLabels records every top-level field of params as a label. Params
+// that are not a JSON object are recorded as a single "param" label.
+func paramLabels(params any) map[string]string {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return map[string]string{"param": labelValue(data)}
+	}
+
+	labels := make(map[string]string, len(fields))
+	for name, value := range fields {
+		labels[MetaLabelParamPrefix+name] = labelValue(value)
+	}
+
+	return labels
+}
+
+func labelValue(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+
+	return string(raw)
+}
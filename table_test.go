@@ -0,0 +1,152 @@
+package axiom_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type signupParams struct {
+	Email string `json:"email"`
+	Valid bool   `json:"valid"`
+}
+
+func newSignupTable() axiom.CaseTable[signupParams] {
+	return axiom.NewCaseTable(
+		axiom.NewCase(
+			axiom.WithCaseID("SIGNUP-1"),
+			axiom.WithCaseName("signup"),
+			axiom.WithCaseMeta(axiom.WithMetaFeature("signup"), axiom.WithMetaLabel("team", "growth")),
+			axiom.WithCaseRetry(axiom.WithRetryTimes(2)),
+		),
+		axiom.NewTableRow("valid email", signupParams{Email: "john@example.com", Valid: true}),
+		axiom.NewTableRow("missing domain", signupParams{Email: "john@"},
+			axiom.WithCaseRetry(axiom.WithRetryTimes(1)),
+		),
+		axiom.NewTableRow("empty email", signupParams{},
+			axiom.WithCaseSkip(axiom.SkipBecause("validation is not ready")),
+		),
+	)
+}
+
+func TestCaseTable_Cases(t *testing.T) {
+	table := newSignupTable()
+
+	cases := table.Cases()
+
+	require.Len(t, cases, 3)
+	assert.Equal(t, "valid email", cases[0].Name)
+	assert.Equal(t, "SIGNUP-1/valid email", cases[0].ID)
+	assert.Equal(t, signupParams{Email: "john@example.com", Valid: true}, cases[0].Params)
+	assert.Equal(t, "signup", cases[0].Meta.Feature)
+	assert.Equal(t, map[string]string{
+		"team":        "growth",
+		"param.email": "john@example.com",
+		"param.valid": "true",
+	}, cases[0].Meta.Labels)
+	assert.Equal(t, 2, cases[0].Retry.Times)
+
+	assert.Equal(t, "SIGNUP-1/missing domain", cases[1].ID)
+	assert.Equal(t, "john@", cases[1].Meta.Labels["param.email"])
+	assert.Equal(t, 1, cases[1].Retry.Times)
+	assert.False(t, cases[1].Skip.Enabled)
+
+	assert.True(t, cases[2].Skip.Enabled)
+	assert.Equal(t, "validation is not ready", cases[2].Skip.Reason)
+
+	assert.Equal(t, "signup", table.Case.Name)
+	assert.Equal(t, map[string]string{"team": "growth"}, table.Case.Meta.Labels)
+}
+
+func TestCaseTable_Cases_ScalarParamsLabel(t *testing.T) {
+	table := axiom.NewCaseTable(axiom.NewCase(), axiom.NewTableRow("answer", 42))
+
+	cases := table.Cases()
+
+	require.Len(t, cases, 1)
+	assert.Empty(t, cases[0].ID)
+	assert.Equal(t, map[string]string{"param": "42"}, cases[0].Meta.Labels)
+}
+
+func TestCaseTable_Cases_InvalidRowNamesPanic(t *testing.T) {
+	assert.PanicsWithValue(t, "table: row name must not be empty", func() {
+		axiom.NewCaseTable(axiom.NewCase(), axiom.NewTableRow("", 1)).Cases()
+	})
+	assert.PanicsWithValue(t, "table: duplicate row name: a", func() {
+		axiom.NewCaseTable(axiom.NewCase(), axiom.NewTableRow("a", 1), axiom.NewTableRow("a", 2)).Cases()
+	})
+}
+
+func TestRunTable_RunsRowsAsSubtestsWithTypedParams(t *testing.T) {
+	var mu sync.Mutex
+	var artefacts []axiom.Artefact
+	runner := axiom.NewRunner(
+		axiom.WithRunnerRuntime(axiom.WithRuntimeArtefactSink(func(a axiom.Artefact) {
+			mu.Lock()
+			defer mu.Unlock()
+			artefacts = append(artefacts, a)
+		})),
+	)
+
+	seen := map[string]signupParams{}
+	axiom.RunTable(t, runner, newSignupTable(), func(cfg *axiom.Config, params signupParams) {
+		mu.Lock()
+		defer mu.Unlock()
+		seen[cfg.SubT.Name()] = params
+	})
+
+	assert.Equal(t, map[string]signupParams{
+		t.Name() + "/signup/valid_email":    {Email: "john@example.com", Valid: true},
+		t.Name() + "/signup/missing_domain": {Email: "john@"},
+	}, seen)
+
+	require.Len(t, artefacts, 2)
+	assert.Equal(t, "params: valid email", artefacts[0].Name)
+	assert.Equal(t, axiom.ArtefactTypeJSON, artefacts[0].Type)
+	assert.JSONEq(t, `{"email":"john@example.com","valid":true}`, string(artefacts[0].Data))
+}
+
+func TestRunTable_NilActionPanics(t *testing.T) {
+	assert.PanicsWithValue(t, "table: nil action", func() {
+		axiom.RunTable(t, axiom.NewRunner(), axiom.NewCaseTable[int](axiom.NewCase()), nil)
+	})
+}
+
+type tableSuite struct {
+	axiom.Suite
+	seen *[]string
+}
+
+func (s *tableSuite) TestSignup() {
+	axiom.RunSuiteTable(&s.Suite, newSignupTable(), func(cfg *axiom.Config, params signupParams) {
+		*s.seen = append(*s.seen, cfg.Case.ID+"="+params.Email)
+	})
+}
+
+func TestRunSuiteTable_RunsRowsWithSuiteRunner(t *testing.T) {
+	var seen []string
+	var names []string
+	runner := axiom.NewRunner(
+		axiom.WithRunnerHooks(axiom.WithBeforeTest(func(cfg *axiom.Config) {
+			names = append(names, cfg.SubT.Name())
+		})),
+	)
+
+	t.Run("suite", func(t *testing.T) {
+		runSuite(t, &tableSuite{seen: &seen}, func(s *axiom.SuiteRunner[*tableSuite]) {
+			s.Test("TestSignup", (*tableSuite).TestSignup)
+		}, axiom.WithSuiteConfigRunner(runner))
+	})
+
+	assert.Equal(t, []string{
+		"SIGNUP-1/valid email=john@example.com",
+		"SIGNUP-1/missing domain=john@",
+	}, seen)
+	assert.Equal(t, []string{
+		t.Name() + "/suite/TestSignup/signup/valid_email",
+		t.Name() + "/suite/TestSignup/signup/missing_domain",
+	}, names)
+}
@@ -0,0 +1,203 @@
+package axiom
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type Timeout struct {
+	Case time.Duration
+	Step time.Duration
+
+	CaseSet bool
+	StepSet bool
+}
+
+type TimeoutOption func(*Timeout)
+
+func NewTimeout(options ...TimeoutOption) Timeout {
+	t := Timeout{}
+	for _, option := range options {
+		option(&t)
+	}
+
+	return t
+}
+
+func WithTimeoutCase(timeout time.Duration) TimeoutOption {
+	return func(t *Timeout) {
+		t.Case = timeout
+		t.CaseSet = true
+	}
+}
+
+func WithTimeoutStep(timeout time.Duration) TimeoutOption {
+	return func(t *Timeout) {
+		t.Step = timeout
+		t.StepSet = true
+	}
+}
+
+func (t *Timeout) Copy() Timeout {
+	return Timeout{
+		Case:    t.Case,
+		Step:    t.Step,
+		CaseSet: t.CaseSet,
+		StepSet: t.StepSet,
+	}
+}
+
+func (t *Timeout) Join(other Timeout) Timeout {
+	result := t.Copy()
+
+	if other.CaseSet {
+		result.Case = other.Case
+		result.CaseSet = true
+	}
+	if other.StepSet {
+		result.Step = other.Step
+		result.StepSet = true
+	}
+
+	return result
+}
+
+func (t *Timeout) Normalize() {
+	if t.Case < 0 {
+		t.Case = 0
+	}
+	if t.Step < 0 {
+		t.Step = 0
+	}
+}
+
+type timeoutError struct {
+	scope   string
+	timeout time.Duration
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %s", e.scope, e.timeout)
+}
+
+// timeoutScope replaces the Config contexts with ones bound to a single
+// deadline. The cause identifies whether this scope's own deadline fired, as
+// opposed to a parent scope or a caller-provided context.
+type timeoutScope struct {
+	ctx      context.Context
+	cause    *timeoutError
+	cancels  []context.CancelFunc
+	fired    chan struct{}
+	step     string
+	captured bool
+	previous Context
+}
+
+func newTimeoutScope(cfg *Config, scope string, timeout time.Duration) *timeoutScope {
+	if timeout <= 0 {
+		return nil
+	}
+
+	s := &timeoutScope{
+		cause: &timeoutError{scope: scope, timeout: timeout},
+		fired: make(chan struct{}),
+	}
+	deadline := time.Now().Add(timeout)
+
+	current := cfg.Context.derive()
+	raw := current.Raw
+	if raw == nil {
+		raw = context.Background()
+	}
+	derived := Context{Raw: s.derive(raw, deadline)}
+	derived.DB = s.deriveFrom(current.DB, raw, derived.Raw, deadline)
+	derived.MQ = s.deriveFrom(current.MQ, raw, derived.Raw, deadline)
+	derived.RPC = s.deriveFrom(current.RPC, raw, derived.Raw, deadline)
+
+	s.ctx = derived.Raw
+	s.previous = cfg.Context.swap(derived)
+	spans := cfg.spans()
+	stop := context.AfterFunc(s.ctx, func() {
+		if context.Cause(s.ctx) == s.cause {
+			spans.mu.Lock()
+			s.captureLocked(spans.currentStepLocked())
+			spans.mu.Unlock()
+		}
+		close(s.fired)
+	})
+	s.cancels = append(s.cancels, func() { stop() })
+
+	spans.mu.Lock()
+	spans.timeouts = append(spans.timeouts, s)
+	spans.mu.Unlock()
+
+	return s
+}
+
+func (s *timeoutScope) derive(parent context.Context, deadline time.Time) context.Context {
+	ctx, cancel := context.WithDeadlineCause(parent, deadline, s.cause)
+	s.cancels = append(s.cancels, cancel)
+
+	return ctx
+}
+
+func (s *timeoutScope) deriveFrom(parent, raw, derived context.Context, deadline time.Time) context.Context {
+	if parent == nil || parent == raw {
+		return derived
+	}
+
+	return s.derive(parent, deadline)
+}
+
+// captureLocked records the step running when the deadline fired. A step that
+// returns on cancellation may finish before the AfterFunc runs, so popping a
+// step frame captures it as well.
+func (s *timeoutScope) captureLocked(step string) {
+	if !s.captured && context.Cause(s.ctx) == s.cause {
+		s.step = step
+		s.captured = true
+	}
+}
+
+func (s *timeoutScope) expired() (bool, string) {
+	if s == nil || context.Cause(s.ctx) != s.cause {
+		return false, ""
+	}
+
+	<-s.fired
+	return true, s.step
+}
+
+func (s *timeoutScope) stop() {
+	if s == nil {
+		return
+	}
+
+	for i := len(s.cancels) - 1; i >= 0; i-- {
+		s.cancels[i]()
+	}
+}
+
+func (s *timeoutScope) restore(cfg *Config) {
+	if s == nil {
+		return
+	}
+
+	s.stop()
+	spans := cfg.spans()
+	spans.mu.Lock()
+	spans.timeouts = slices.DeleteFunc(spans.timeouts, func(scope *timeoutScope) bool { return scope == s })
+	spans.mu.Unlock()
+
+	cfg.Context.swap(s.previous)
+}
+
+func timeoutMessage(name string, timeout time.Duration, step string) string {
+	if step == "" {
+		return fmt.Sprintf("test %q timed out after %s", name, timeout)
+	}
+
+	return fmt.Sprintf("test %q timed out after %s in step %q", name, timeout, step)
+}
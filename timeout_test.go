@@ -0,0 +1,188 @@
+package axiom_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Nikita-Filonov/axiom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTimeout_Defaults(t *testing.T) {
+	timeout := axiom.NewTimeout()
+
+	assert.Equal(t, time.Duration(0), timeout.Case)
+	assert.Equal(t, time.Duration(0), timeout.Step)
+	assert.False(t, timeout.CaseSet)
+	assert.False(t, timeout.StepSet)
+}
+
+func TestTimeoutJoin_OverridesPerField(t *testing.T) {
+	base := axiom.NewTimeout(
+		axiom.WithTimeoutCase(time.Minute),
+		axiom.WithTimeoutStep(10*time.Second),
+	)
+	other := axiom.NewTimeout(axiom.WithTimeoutStep(time.Second))
+
+	result := base.Join(other)
+
+	assert.Equal(t, time.Minute, result.Case)
+	assert.Equal(t, time.Second, result.Step)
+	assert.True(t, result.CaseSet)
+	assert.True(t, result.StepSet)
+}
+
+func TestTimeoutJoin_OverridesToZero_WhenSet(t *testing.T) {
+	base := axiom.NewTimeout(axiom.WithTimeoutCase(time.Minute))
+	other := axiom.NewTimeout(axiom.WithTimeoutCase(0))
+
+	result := base.Join(other)
+
+	assert.Equal(t, time.Duration(0), result.Case)
+	assert.True(t, result.CaseSet)
+}
+
+func TestTimeoutNormalize_FixesNegativeValues(t *testing.T) {
+	timeout := axiom.NewTimeout(
+		axiom.WithTimeoutCase(-time.Second),
+		axiom.WithTimeoutStep(-time.Second),
+	)
+	timeout.Normalize()
+
+	assert.Equal(t, time.Duration(0), timeout.Case)
+	assert.Equal(t, time.Duration(0), timeout.Step)
+}
+
+func TestTimeoutCopy(t *testing.T) {
+	timeout := axiom.NewTimeout(axiom.WithTimeoutCase(time.Second))
+
+	assert.Equal(t, timeout, timeout.Copy())
+}
+
+func TestConfig_Test_CaseTimeoutCancelsContextsAndReportsStep(t *testing.T) {
+	fakeT := &testing.T{}
+	db := context.WithValue(context.Background(), struct{}{}, "db")
+
+	var events []axiom.Event
+	var cleanedUp bool
+	var dbErr error
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{Name: "case"},
+		Context: axiom.NewContext(axiom.WithContextDB(db)),
+		Timeout: axiom.NewTimeout(axiom.WithTimeoutCase(20 * time.Millisecond)),
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeEventSink(func(e axiom.Event) {
+				events = append(events, e)
+			}),
+		),
+		SubT: fakeT,
+	}
+	cfg.Context.Normalize()
+	cfg.Fixtures.Cleanups = append(cfg.Fixtures.Cleanups, func(*axiom.Config) { cleanedUp = true })
+
+	cfg.Test(func(c *axiom.Config) {
+		c.Step("hung rpc", func() {
+			<-c.Context.RPC.Done()
+			<-c.Context.DB.Done()
+			dbErr = c.Context.DB.Err()
+		})
+	})
+
+	assert.True(t, fakeT.Failed())
+	assert.True(t, cleanedUp)
+	assert.ErrorIs(t, dbErr, context.DeadlineExceeded)
+	assert.Same(t, db, cfg.Context.DB, "the original contexts must be restored")
+
+	var timeoutEvent *axiom.Event
+	for i := range events {
+		if events[i].Type == axiom.EventTypeCaseTimeout {
+			timeoutEvent = &events[i]
+		}
+	}
+	require.NotNil(t, timeoutEvent)
+	assert.Equal(t, "hung rpc", timeoutEvent.Name)
+	assert.Equal(t, "case timed out after 20ms", timeoutEvent.Message)
+}
+
+func TestConfig_Step_StepTimeoutFailsOnlyTimedOutStep(t *testing.T) {
+	fakeT := &testing.T{}
+
+	var events []axiom.Event
+	var secondStepErr error
+	cfg := &axiom.Config{
+		Timeout: axiom.NewTimeout(axiom.WithTimeoutStep(10 * time.Millisecond)),
+		Runtime: axiom.NewRuntime(
+			axiom.WithRuntimeEventSink(func(e axiom.Event) {
+				events = append(events, e)
+			}),
+		),
+		SubT: fakeT,
+	}
+	cfg.Context.Normalize()
+
+	cfg.Step("slow", func() { <-cfg.Context.Raw.Done() })
+	cfg.Step("fast", func() { secondStepErr = cfg.Context.Raw.Err() })
+
+	assert.True(t, fakeT.Failed())
+	assert.NoError(t, secondStepErr, "every step must receive a fresh deadline")
+
+	var types []axiom.EventType
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []axiom.EventType{
+		axiom.EventTypeStepStart,
+		axiom.EventTypeStepTimeout,
+		axiom.EventTypeStepFinish,
+		axiom.EventTypeStepStart,
+		axiom.EventTypeStepFinish,
+	}, types)
+	assert.Equal(t, "slow", events[1].Name)
+}
+
+func TestConfig_Test_ParentCancellationIsNotReportedAsTimeout(t *testing.T) {
+	fakeT := &testing.T{}
+	parent, cancel := context.WithCancel(context.Background())
+
+	cfg := &axiom.Config{
+		Case:    &axiom.Case{Name: "case"},
+		Context: axiom.NewContext(axiom.WithContextRaw(parent)),
+		Timeout: axiom.NewTimeout(axiom.WithTimeoutCase(time.Minute)),
+		SubT:    fakeT,
+	}
+	cfg.Context.Normalize()
+
+	var err error
+	cfg.Test(func(c *axiom.Config) {
+		cancel()
+		<-c.Context.Raw.Done()
+		err = c.Context.Raw.Err()
+	})
+
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.False(t, fakeT.Failed())
+}
+
+func TestConfig_Step_StepTimeoutCancelsContextRaw(t *testing.T) {
+	fakeT := &testing.T{}
+	cfg := &axiom.Config{
+		Timeout: axiom.NewTimeout(axiom.WithTimeoutStep(20 * time.Millisecond)),
+		SubT:    fakeT,
+	}
+	cfg.Context.Normalize()
+	raw := cfg.Context.Raw
+
+	var err error
+	cfg.Step("hung call", func() {
+		<-cfg.Context.Raw.Done()
+		err = cfg.Context.Raw.Err()
+	})
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, fakeT.Failed())
+	assert.Equal(t, raw, cfg.Context.Raw, "the step restores the contexts when it ends")
+	assert.NoError(t, cfg.Context.Raw.Err())
+}